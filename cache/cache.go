@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/the-steam-hub/discord-bot/metrics"
+)
+
+// Cache is a concurrency safe in-memory key/value store. Every entry expires
+// after the time-to-live the cache was created with. Lookups are recorded
+// under the cache's name so the hit ratio can be monitored.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	name    string
+	ttl     time.Duration
+	entries map[K]entry[V]
+	// Size at which Set removes expired entries
+	pruneAt int
+}
+
+// Expired entries are pruned once the map grows past this size
+const pruneThreshold = 1024
+
+type entry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+func New[K comparable, V any](name string, ttl time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		name:    name,
+		ttl:     ttl,
+		entries: map[K]entry[V]{},
+		pruneAt: pruneThreshold,
+	}
+}
+
+// Get returns the value stored for key and whether it was found. Expired
+// entries are removed and reported as missing.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if ok && time.Now().After(e.expires) {
+		delete(c.entries, key)
+		ok = false
+	}
+
+	metrics.CacheLookup(c.name, ok)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+// Set stores value for key. Expired entries of keys that are never read
+// again are swept once the cache grows past its prune size.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.entries[key] = entry[V]{
+		value:   value,
+		expires: now.Add(c.ttl),
+	}
+
+	if len(c.entries) > c.pruneAt {
+		c.prune(now)
+	}
+}
+
+// prune removes expired entries. The prune size grows with the entries that
+// are still valid so a full cache is not swept on every Set. The caller must
+// hold the lock.
+func (c *Cache[K, V]) prune(now time.Time) {
+	for key, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, key)
+		}
+	}
+	c.pruneAt = max(pruneThreshold, 2*len(c.entries))
+}
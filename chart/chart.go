@@ -0,0 +1,134 @@
+package chart
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+)
+
+// Slice is one value of a chart and the colour it is drawn in
+type Slice struct {
+	Value float64
+	Color color.RGBA
+}
+
+// Pie draws a pie chart of the slices on a transparent square image. Slices
+// start at the top and go clockwise, slices without a positive value are
+// left out.
+func Pie(slices []Slice, size int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+
+	total := 0.0
+	for _, s := range slices {
+		if s.Value > 0 {
+			total += s.Value
+		}
+	}
+	if total == 0 {
+		return img
+	}
+
+	// Angle at which each slice ends, as a fraction of the full circle
+	ends := make([]float64, len(slices))
+	acc := 0.0
+	for i, s := range slices {
+		if s.Value > 0 {
+			acc += s.Value / total
+		}
+		ends[i] = acc
+	}
+
+	center := float64(size) / 2
+	radius := center - 1
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dx := float64(x) + 0.5 - center
+			dy := float64(y) + 0.5 - center
+			dist := math.Hypot(dx, dy)
+			if dist > radius+0.5 {
+				continue
+			}
+
+			// Clockwise from the top, in [0, 1)
+			angle := math.Atan2(dx, -dy) / (2 * math.Pi)
+			if angle < 0 {
+				angle++
+			}
+
+			c := slices[len(slices)-1].Color
+			for i, end := range ends {
+				if angle < end && slices[i].Value > 0 {
+					c = slices[i].Color
+					break
+				}
+			}
+
+			// Blend the outermost pixels to smooth the edge of the circle
+			if dist > radius-0.5 {
+				c.A = uint8(float64(c.A) * (radius + 0.5 - dist))
+			}
+			img.SetRGBA(x, y, premultiply(c))
+		}
+	}
+
+	return img
+}
+
+// Bar draws a bar chart of the values on a transparent image, bars are
+// scaled to the largest value and evenly spaced from left to right
+func Bar(bars []Slice, width int, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	largest := 0.0
+	for _, b := range bars {
+		largest = max(largest, b.Value)
+	}
+	if largest == 0 {
+		return img
+	}
+
+	slot := float64(width) / float64(len(bars))
+	gap := slot / 5
+	for i, b := range bars {
+		if b.Value <= 0 {
+			continue
+		}
+
+		x0 := int(math.Round(float64(i)*slot + gap/2))
+		x1 := max(x0+1, int(math.Round(float64(i+1)*slot-gap/2)))
+		// Small values still get a visible bar
+		h := max(1, int(math.Round(b.Value/largest*float64(height))))
+
+		c := premultiply(b.Color)
+		for y := height - h; y < height; y++ {
+			for x := x0; x < x1; x++ {
+				img.SetRGBA(x, y, c)
+			}
+		}
+	}
+
+	return img
+}
+
+// PNG encodes an image as PNG
+func PNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// premultiply converts a colour with straight alpha to the premultiplied
+// alpha image.RGBA expects
+func premultiply(c color.RGBA) color.RGBA {
+	a := uint16(c.A)
+	return color.RGBA{
+		R: uint8(uint16(c.R) * a / 255),
+		G: uint8(uint16(c.G) * a / 255),
+		B: uint8(uint16(c.B) * a / 255),
+		A: c.A,
+	}
+}
@@ -0,0 +1,236 @@
+package banwatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/locale"
+	"github.com/the-steam-hub/discord-bot/steam"
+	"github.com/the-steam-hub/discord-bot/store"
+	"github.com/the-steam-hub/discord-bot/watch"
+)
+
+const (
+	// Watches shown by /banwatch list, the rest are only in the export
+	maxListed  = 25
+	exportName = "banwatch.csv"
+)
+
+func Add(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, dataStore *store.Store, maxPerGuild int, input string) error {
+	loc := interaction.Locale
+
+	if maxPerGuild > 0 && len(dataStore.GuildBanWatches(interaction.GuildID)) >= maxPerGuild {
+		return cmd.NewUserError(nil, "this server already watches the bans of %d players, which is the limit", maxPerGuild)
+	}
+
+	_, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
+	if err != nil {
+		return err
+	}
+
+	bans, err := steamClient.BanStatuses(ctx, player.SteamID)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve player bans")
+	}
+
+	now := time.Now()
+	w, err := dataStore.AddBanWatch(store.BanWatch{
+		SteamID:     player.SteamID,
+		PlayerName:  player.Name,
+		GuildID:     interaction.GuildID,
+		ChannelID:   interaction.ChannelID,
+		UserID:      interaction.Member.User.ID,
+		Locale:      string(loc.Discord()),
+		AddedAt:     now,
+		Bans:        watch.NewBanSnapshot(bans[0]),
+		LastChecked: now,
+	})
+	if errors.Is(err, store.ErrAlreadyWatched) {
+		return cmd.NewUserError(err, "this player is already watched, see watch %s", w.ID)
+	}
+	if err != nil {
+		return cmd.NewUserError(err, "unable to save watch")
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
+		},
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: player.AvatarFull,
+		},
+		Description: loc.Sprintf("This channel will be notified when the bans of this player change."),
+		Color:       0x66c0f4,
+		Fields:      banFields(loc, w.Bans),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: loc.Sprintf("Watch ID: %s", w.ID),
+		},
+	}
+	return interaction.Respond(embMsg)
+}
+
+func List(ctx context.Context, interaction *cmd.Interaction, dataStore *store.Store) error {
+	loc := interaction.Locale
+	watches := dataStore.GuildBanWatches(interaction.GuildID)
+
+	IDs, players, bans := "", "", ""
+	for _, w := range watches[:min(maxListed, len(watches))] {
+		IDs += fmt.Sprintf("`%s`\n", w.ID)
+		players += fmt.Sprintf("%s\n", w.PlayerName)
+		bans += loc.Sprintf("%d VAC, %d game", w.Bans.VACBans, w.Bans.GameBans) + "\n"
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title: loc.Sprintf("Ban Watch (%d players)", len(watches)),
+		Color: 0x66c0f4,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.Sprintf("ID"),
+				Value:  cmd.HandleStringDefault(IDs),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Player"),
+				Value:  cmd.HandleStringDefault(players),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Bans"),
+				Value:  cmd.HandleStringDefault(bans),
+				Inline: true,
+			},
+		},
+	}
+
+	if len(watches) > maxListed {
+		embMsg.Footer = &discordgo.MessageEmbedFooter{
+			Text: loc.Sprintf("Showing %d of %d players, use /banwatch export for the full list", maxListed, len(watches)),
+		}
+	}
+
+	return interaction.Respond(embMsg)
+}
+
+func Remove(ctx context.Context, interaction *cmd.Interaction, dataStore *store.Store, input string) error {
+	loc := interaction.Locale
+
+	err := dataStore.RemoveBanWatch(input, interaction.GuildID)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to remove watch, check the ID with /banwatch list")
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title:       loc.Sprintf("Watch Removed"),
+		Description: loc.Sprintf("Watch `%s` has been removed.", input),
+		Color:       0x66c0f4,
+	}
+	return interaction.Respond(embMsg)
+}
+
+// Export attaches every watch of the guild and its last observed bans as
+// a CSV file
+func Export(ctx context.Context, interaction *cmd.Interaction, dataStore *store.Store) error {
+	loc := interaction.Locale
+	watches := dataStore.GuildBanWatches(interaction.GuildID)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Write([]string{
+		"watch_id", "steam_id", "player_name", "added_at", "added_by", "vac_bans", "game_bans",
+		"community_banned", "economy_ban", "days_since_last_ban", "last_checked", "last_change",
+	})
+	for _, w := range watches {
+		writer.Write([]string{
+			w.ID,
+			w.SteamID,
+			csvSafe(w.PlayerName),
+			formatTime(w.AddedAt),
+			w.UserID,
+			strconv.Itoa(w.Bans.VACBans),
+			strconv.Itoa(w.Bans.GameBans),
+			strconv.FormatBool(w.Bans.CommunityBanned),
+			w.Bans.EconomyBan,
+			strconv.Itoa(w.Bans.DaysSinceLastBan),
+			formatTime(w.LastChecked),
+			formatTime(w.LastChange),
+		})
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return cmd.NewUserError(err, "unable to export watches")
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title:       loc.Sprintf("Ban Watch Export"),
+		Description: loc.Sprintf("Exported %d watched players.", len(watches)),
+		Color:       0x66c0f4,
+	}
+	return interaction.RespondFiles(embMsg, []*discordgo.File{
+		{
+			Name:        exportName,
+			ContentType: "text/csv",
+			Reader:      &buf,
+		},
+	})
+}
+
+func banFields(loc locale.Locale, bans store.BanSnapshot) []*discordgo.MessageEmbedField {
+	return []*discordgo.MessageEmbedField{
+		{
+			Name:   loc.Sprintf("VAC Bans"),
+			Value:  strconv.Itoa(bans.VACBans),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Game Bans"),
+			Value:  strconv.Itoa(bans.GameBans),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Days Since Last Ban"),
+			Value:  fmt.Sprintf("%dd", bans.DaysSinceLastBan),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Community Banned"),
+			Value:  strconv.FormatBool(bans.CommunityBanned),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Economy Banned"),
+			Value:  bans.EconomyBan,
+			Inline: true,
+		},
+		{
+			Name:   "",
+			Value:  "",
+			Inline: true,
+		},
+	}
+}
+
+// csvSafe stops spreadsheets from evaluating player names as formulas
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@") {
+		return "'" + value
+	}
+	return value
+}
+
+// formatTime formats a time for the export, zero times are left empty
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
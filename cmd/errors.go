@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/locale"
+	"github.com/the-steam-hub/discord-bot/steam"
+)
+
+// Color of error embeds
+const errorColor = 0xd9534f
+
+// UserError is an error whose message is shown to the user. Message and
+// Hint are English text translated when rendered, Err is only logged.
+type UserError struct {
+	Message string
+	Args    []interface{}
+	// Hint tells the user how to fix the problem, when empty a hint is
+	// picked based on Err
+	Hint string
+	Err  error
+}
+
+func NewUserError(err error, msg string, args ...interface{}) *UserError {
+	return &UserError{
+		Message: msg,
+		Args:    args,
+		Err:     err,
+	}
+}
+
+// WithHint sets the hint shown below the error message
+func (e *UserError) WithHint(hint string) *UserError {
+	e.Hint = hint
+	return e
+}
+
+func (e *UserError) Error() string {
+	msg := fmt.Sprintf(e.Message, e.Args...)
+	if e.Err != nil {
+		return msg + ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *UserError) Unwrap() error {
+	return e.Err
+}
+
+// GameNotFound is returned by handlers when a store search fails. Only a
+// search without results gets the spelling hint, outages keep theirs.
+func GameNotFound(err error) error {
+	userErr := NewUserError(err, "game not found")
+	if errors.Is(err, steam.ErrAppNotFound) {
+		userErr.WithHint("check the spelling or use the full name shown on the store page")
+	}
+	return userErr
+}
+
+// errorEmbed renders err for the user, errors which are not a UserError
+// only show a generic message
+func errorEmbed(loc locale.Locale, err error) *discordgo.MessageEmbed {
+	embMsg := &discordgo.MessageEmbed{
+		Description: loc.Sprintf("something went wrong, please try again later"),
+		Color:       errorColor,
+	}
+
+	hint := steamHint(err)
+
+	var userErr *UserError
+	if errors.As(err, &userErr) {
+		embMsg.Description = loc.Sprintf(userErr.Message, userErr.Args...)
+		if userErr.Hint != "" {
+			hint = userErr.Hint
+		}
+	}
+
+	if hint != "" {
+		embMsg.Fields = []*discordgo.MessageEmbedField{
+			{
+				Name:  loc.Sprintf("Hint"),
+				Value: loc.Sprintf(hint),
+			},
+		}
+	}
+
+	return embMsg
+}
+
+// steamHint returns a hint for the sentinel errors of the steam package
+func steamHint(err error) string {
+	switch {
+	case errors.Is(err, steam.ErrPrivateProfile):
+		return "this profile is private, ask the user to make their game details and friends list public"
+	case errors.Is(err, steam.ErrVanityNotFound):
+		return "vanity URL not found, check the spelling or use the link to the profile"
+	case errors.Is(err, steam.ErrMalformedURL):
+		return "use a profile link such as https://steamcommunity.com/id/name or https://steamcommunity.com/profiles/76561197960287930"
+	case errors.Is(err, steam.ErrInvalidSteamID):
+		return "Steam IDs look like 76561197960287930, STEAM_1:0:11101 or [U:1:22202]"
+	case errors.Is(err, steam.ErrGroupNotFound):
+		return "check the group name or use the link to the group page"
+	case errors.Is(err, steam.ErrUserNotFound):
+		return "no player has this Steam ID, check it with /player id"
+	case errors.Is(err, steam.ErrRateLimited):
+		return "Steam is rate limiting us, please try again in a minute"
+	case errors.Is(err, steam.ErrUnavailable):
+		return "Steam is not responding, it may be down for maintenance"
+	}
+	return ""
+}
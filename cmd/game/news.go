@@ -1,49 +1,33 @@
 package game
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
 	"github.com/the-steam-hub/discord-bot/cmd"
 	"github.com/the-steam-hub/discord-bot/steam"
 )
 
-func AppNews(session *discordgo.Session, interaction *discordgo.InteractionCreate, steamClient steam.Steam, input string) {
-	logs := logrus.Fields{
-		"input":  input,
-		"author": interaction.Member.User.Username,
-		"uuid":   uuid.New(),
-	}
+func AppNews(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string, region string) error {
+	loc := interaction.Locale
+	storeLocale := loc.StoreLocale(region)
 
-	appID, err := steamClient.AppSearch(input)
+	appID, err := steamClient.AppSearch(ctx, input, storeLocale)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "game not found"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return cmd.GameNotFound(err)
 	}
 
-	appData, err := steamClient.AppDetailedData(appID)
+	appData, err := steamClient.AppDetailedData(ctx, appID, storeLocale)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to retrieve game data"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return cmd.NewUserError(err, "unable to retrieve game data")
 	}
 
-	appNews, err := steamClient.AppNews(appID)
+	appNews, err := steamClient.AppNews(ctx, appID)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to retrieve game news"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return cmd.NewUserError(err, "unable to retrieve game news")
 	}
 
 	embMsg := &discordgo.MessageEmbed{
@@ -56,7 +40,7 @@ func AppNews(session *discordgo.Session, interaction *discordgo.InteractionCreat
 		Color:       0x66c0f4,
 	}
 
-	cmd.HandleMessageOk(embMsg, session, interaction, &logs)
+	return interaction.Respond(embMsg)
 }
 
 func trimTitle(input string) string {
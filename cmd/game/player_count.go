@@ -1,53 +1,33 @@
 package game
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
 	"github.com/the-steam-hub/discord-bot/cmd"
 	"github.com/the-steam-hub/discord-bot/steam"
-	"golang.org/x/text/language"
-	"golang.org/x/text/message"
 )
 
-func AppPlayerCount(session *discordgo.Session, interaction *discordgo.InteractionCreate, steamClient steam.Steam, input string) {
-	logs := logrus.Fields{
-		"input":  input,
-		"author": interaction.Member.User.Username,
-		"uuid":   uuid.New(),
-	}
+func AppPlayerCount(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string, region string) error {
+	loc := interaction.Locale
+	storeLocale := loc.StoreLocale(region)
 
-	appID, err := steamClient.AppSearch(input)
+	appID, err := steamClient.AppSearch(ctx, input, storeLocale)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to find game"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return cmd.GameNotFound(err)
 	}
 
-	appPlayerCount, err := steamClient.AppPlayerCount(appID)
+	appPlayerCount, err := steamClient.AppPlayerCount(ctx, appID)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to retrieve player count"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return cmd.NewUserError(err, "unable to retrieve player count")
 	}
 
-	appData, err := steamClient.AppDetailedData(appID)
+	appData, err := steamClient.AppDetailedData(ctx, appID, storeLocale)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to retrieve game data"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return cmd.NewUserError(err, "unable to retrieve game data")
 	}
 
-	p := message.NewPrinter(language.English)
-
 	embMsg := &discordgo.MessageEmbed{
 		Title: trimTitle(appData.Name),
 		URL:   steam.SteamPoweredAPI + "app/" + strconv.Itoa(appID),
@@ -57,21 +37,21 @@ func AppPlayerCount(session *discordgo.Session, interaction *discordgo.Interacti
 		Color: 0x66c0f4,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "Players",
-				Value:  p.Sprintf("%d", appPlayerCount.Current),
+				Name:   loc.Sprintf("Players"),
+				Value:  loc.Sprintf("%d", appPlayerCount.Current),
 				Inline: true,
 			},
 			{
-				Name:   "24h Peak",
-				Value:  p.Sprintf("%d", appPlayerCount.Peak24Hour),
+				Name:   loc.Sprintf("24h Peak"),
+				Value:  loc.Sprintf("%d", appPlayerCount.Peak24Hour),
 				Inline: true,
 			},
 			{
-				Name:   "All-Time Peak",
-				Value:  p.Sprintf("%d", appPlayerCount.PeakAllTime),
+				Name:   loc.Sprintf("All-Time Peak"),
+				Value:  loc.Sprintf("%d", appPlayerCount.PeakAllTime),
 				Inline: true,
 			},
 		},
 	}
-	cmd.HandleMessageOk(embMsg, session, interaction, &logs)
+	return interaction.Respond(embMsg)
 }
@@ -0,0 +1,194 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/exchange"
+	"github.com/the-steam-hub/discord-bot/locale"
+	"github.com/the-steam-hub/discord-bot/steam"
+)
+
+const (
+	maxRegions = 10
+)
+
+// Regions compared when the user does not provide any
+var defaultRegions = []string{"US", "GB", "DE", "CA", "AU", "BR", "PL", "TR", "AR", "JP"}
+
+func AppPrice(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string, regionsInput string, defaultRegion string) error {
+	loc := interaction.Locale
+	storeLocale := loc.StoreLocale(defaultRegion)
+
+	regions, err := parseRegions(regionsInput, storeLocale.CountryCode)
+	if err != nil {
+		return cmd.NewUserError(err, "invalid region, expected two letter country codes such as US, GB, DE")
+	}
+
+	appID, err := steamClient.AppSearch(ctx, input, storeLocale)
+	if err != nil {
+		return cmd.GameNotFound(err)
+	}
+
+	appData, err := steamClient.AppDetailedData(ctx, appID, storeLocale)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve game data")
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title: trimTitle(appData.Name),
+		URL:   steam.SteamPoweredAPI + "app/" + strconv.Itoa(appID),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: appData.HeaderImage,
+		},
+		Color: 0x66c0f4,
+	}
+
+	if appData.IsFree {
+		embMsg.Description = loc.Sprintf("This game is free to play in every region.")
+		return interaction.Respond(embMsg)
+	}
+
+	regionalPrices := steamClient.AppRegionalPrices(ctx, appID, regions...)
+	base := baseRegionalPrice(regionalPrices)
+	// Without rates only prices in the base currency can be compared
+	rates, err := exchange.Latest(ctx)
+	if err != nil {
+		interaction.Logger.WithError(err).Error("unable to retrieve exchange rates")
+	}
+	cheapest := cheapestRegion(regionalPrices, base, rates)
+
+	for _, v := range regionalPrices {
+		if v.Err != nil {
+			interaction.Logger.WithError(v.Err).Errorf("unable to retrieve price for region %s", v.CountryCode)
+		}
+
+		name := fmt.Sprintf("%s %s", steam.CountryFlag(v.CountryCode), v.CountryCode)
+		if v.CountryCode == cheapest {
+			name += " 🏷️"
+		}
+
+		embMsg.Fields = append(embMsg.Fields, &discordgo.MessageEmbedField{
+			Name:   name,
+			Value:  formatRegionalPrice(loc, v, base, rates),
+			Inline: true,
+		})
+	}
+
+	if base != nil {
+		embMsg.Footer = &discordgo.MessageEmbedFooter{
+			Text: loc.Sprintf("Converted prices are approximate and relative to %s. 🏷️ marks the cheapest region.", base.CountryCode),
+		}
+	}
+
+	return interaction.Respond(embMsg)
+}
+
+// parseRegions turns a comma or space separated list of country codes into
+// a deduplicated list, the guild's default region is always compared first
+func parseRegions(input string, defaultRegion string) ([]string, error) {
+	if defaultRegion == "" {
+		defaultRegion = steam.DefaultCountryCode
+	}
+
+	fields := strings.FieldsFunc(input, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	if len(fields) == 0 {
+		fields = defaultRegions
+	}
+
+	regions := []string{defaultRegion}
+	for _, v := range fields {
+		cc, err := steam.ParseCountryCode(v)
+		if err != nil {
+			return nil, err
+		}
+
+		duplicate := false
+		for _, r := range regions {
+			if r == cc {
+				duplicate = true
+			}
+		}
+
+		if !duplicate && len(regions) < maxRegions {
+			regions = append(regions, cc)
+		}
+	}
+
+	return regions, nil
+}
+
+// baseRegionalPrice returns the first region with a price, all other
+// regions are converted into its currency for comparison
+func baseRegionalPrice(regionalPrices []steam.AppRegionalPrice) *steam.AppRegionalPrice {
+	for k, v := range regionalPrices {
+		if v.Price != nil {
+			return &regionalPrices[k]
+		}
+	}
+	return nil
+}
+
+func cheapestRegion(regionalPrices []steam.AppRegionalPrice, base *steam.AppRegionalPrice, rates exchange.Rates) string {
+	if base == nil {
+		return ""
+	}
+
+	cheapest, lowest := "", math.MaxFloat64
+	for _, v := range regionalPrices {
+		if v.Price == nil {
+			continue
+		}
+
+		converted, err := rates.Convert(float64(v.Price.Final)/100, v.Price.Currency, base.Price.Currency)
+		if err != nil {
+			continue
+		}
+
+		if converted < lowest {
+			cheapest, lowest = v.CountryCode, converted
+		}
+	}
+
+	return cheapest
+}
+
+func formatRegionalPrice(loc locale.Locale, regionalPrice steam.AppRegionalPrice, base *steam.AppRegionalPrice, rates exchange.Rates) string {
+	if regionalPrice.Err != nil {
+		return loc.Sprintf("Unavailable")
+	}
+
+	if regionalPrice.Price == nil {
+		return loc.Sprintf("Not sold")
+	}
+
+	price := regionalPrice.Price
+	format := price.FinalFormatted
+	if price.DiscountPercent > 0 {
+		format = fmt.Sprintf("~~%s~~ %s\n-%d%%", price.InitialFormatted, price.FinalFormatted, price.DiscountPercent)
+	}
+
+	if base == nil || base.CountryCode == regionalPrice.CountryCode {
+		return format
+	}
+
+	converted, err := rates.Convert(float64(price.Final)/100, price.Currency, base.Price.Currency)
+	if err != nil {
+		return format
+	}
+
+	baseFinal := float64(base.Price.Final) / 100
+	if baseFinal == 0 {
+		return format
+	}
+
+	difference := (converted - baseFinal) / baseFinal * 100
+	return fmt.Sprintf("%s\n≈ %.2f %s (%+.0f%%)", format, converted, base.Price.Currency, difference)
+}
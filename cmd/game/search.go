@@ -1,40 +1,29 @@
 package game
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
 	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/locale"
 	"github.com/the-steam-hub/discord-bot/steam"
 )
 
-func AppSearch(session *discordgo.Session, interaction *discordgo.InteractionCreate, steamClient steam.Steam, input string) {
-	logs := logrus.Fields{
-		"input":  input,
-		"author": interaction.Member.User.Username,
-		"uuid":   uuid.New(),
-	}
+func AppSearch(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string, region string) error {
+	loc := interaction.Locale
+	storeLocale := loc.StoreLocale(region)
 
-	appID, err := steamClient.AppSearch(input)
+	appID, err := steamClient.AppSearch(ctx, input, storeLocale)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "game not found"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return cmd.GameNotFound(err)
 	}
 
-	appData, err := steamClient.AppDetailedData(appID)
+	appData, err := steamClient.AppDetailedData(ctx, appID, storeLocale)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to retrieve game data"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return cmd.NewUserError(err, "unable to retrieve game data")
 	}
 
 	embMsg := &discordgo.MessageEmbed{
@@ -47,43 +36,43 @@ func AppSearch(session *discordgo.Session, interaction *discordgo.InteractionCre
 		Color: 0x66c0f4,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "Price",
-				Value:  cmd.HandleStringDefault(formatPrice(*appData)),
+				Name:   loc.Sprintf("Price"),
+				Value:  cmd.HandleStringDefault(formatPrice(loc, *appData)),
 				Inline: true,
 			},
 			{
-				Name:   "Release Date",
+				Name:   loc.Sprintf("Release Date"),
 				Value:  cmd.HandleStringDefault(appData.ReleaseDate.Date),
 				Inline: true,
 			},
 			{
-				Name:   "# DLC",
+				Name:   loc.Sprintf("# DLC"),
 				Value:  strconv.Itoa(len(appData.DLC)),
 				Inline: true,
 			},
 			{
-				Name:   "Developers",
+				Name:   loc.Sprintf("Developers"),
 				Value:  cmd.HandleStringDefault(strings.Join(appData.Developers, ", ")),
 				Inline: true,
 			},
 			{
-				Name:   "Publishers",
+				Name:   loc.Sprintf("Publishers"),
 				Value:  cmd.HandleStringDefault(strings.Join(appData.Publishers, ", ")),
 				Inline: true,
 			},
 			{
-				Name:   "Genres",
+				Name:   loc.Sprintf("Genres"),
 				Value:  cmd.HandleStringDefault(formatGenres(*appData)),
 				Inline: true,
 			},
 		},
 	}
-	cmd.HandleMessageOk(embMsg, session, interaction, &logs)
+	return interaction.Respond(embMsg)
 }
 
-func formatPrice(appData steam.AppDetailedData) string {
+func formatPrice(loc locale.Locale, appData steam.AppDetailedData) string {
 	if appData.IsFree {
-		return "Free"
+		return loc.Sprintf("Free")
 	}
 
 	iFormat := appData.PriceOverview.InitialFormatted
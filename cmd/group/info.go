@@ -0,0 +1,108 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/steam"
+)
+
+const (
+	// Members shown on each page of /group info, keeps the field below
+	// Discord's 1024 character limit
+	membersPerPage = 20
+)
+
+func GroupInfo(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string, showMembers bool, page int) error {
+	loc := interaction.Locale
+
+	groupID, err := steamClient.ResolveGroupID(ctx, input)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to resolve group ID")
+	}
+
+	// The XML member list has more members per page than we show, so only
+	// the page containing the requested members is fetched
+	first := (page - 1) * membersPerPage
+	group, err := steamClient.GroupMembers(ctx, groupID, first/steam.GroupMembersPageSize+1)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve group information")
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title:       group.Name,
+		URL:         group.ProfileURL(),
+		Description: group.Headline,
+		Color:       0x66c0f4,
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: group.AvatarFull,
+		},
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.Sprintf("Members"),
+				Value:  strconv.Itoa(group.MemberCount),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Online"),
+				Value:  strconv.Itoa(group.MembersOnline),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("In-Game"),
+				Value:  strconv.Itoa(group.MembersInGame),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("In Chat"),
+				Value:  strconv.Itoa(group.MembersInChat),
+				Inline: true,
+			},
+		},
+	}
+
+	if !showMembers {
+		return interaction.Respond(embMsg)
+	}
+
+	pages := max((group.MemberCount+membersPerPage-1)/membersPerPage, 1)
+	if page > pages {
+		return cmd.NewUserError(nil, "page %d does not exist, this group has %d pages", page, pages)
+	}
+
+	offset := first % steam.GroupMembersPageSize
+	IDs := group.MemberIDs[min(offset, len(group.MemberIDs)):min(offset+membersPerPage, len(group.MemberIDs))]
+
+	members := ""
+	if len(IDs) > 0 {
+		players, err := steamClient.PlayerSummaries(ctx, IDs...)
+		if err != nil {
+			return cmd.NewUserError(err, "unable to retrieve group members")
+		}
+
+		// Summaries are not returned in the order they were requested
+		byID := make(map[string]steam.Player, len(players))
+		for _, v := range players {
+			byID[v.SteamID] = v
+		}
+
+		for _, ID := range IDs {
+			if p, ok := byID[ID]; ok {
+				members += fmt.Sprintf("%s %s\n", p.Status(), p.Name)
+			}
+		}
+	}
+
+	embMsg.Fields = append(embMsg.Fields, &discordgo.MessageEmbedField{
+		Name:  loc.Sprintf("Member List"),
+		Value: cmd.HandleStringDefault(members),
+	})
+	embMsg.Footer = &discordgo.MessageEmbedFooter{
+		Text: loc.Sprintf("Page %d of %d", page, pages),
+	}
+
+	return interaction.Respond(embMsg)
+}
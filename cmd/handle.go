@@ -1,45 +1,89 @@
 package cmd
 
 import (
+	"context"
+	"strings"
+
 	"github.com/bwmarrin/discordgo"
-	"github.com/sirupsen/logrus"
+	"github.com/the-steam-hub/discord-bot/steam"
 )
 
-func HandleMessageError(session *discordgo.Session, interaction *discordgo.InteractionCreate, logs *logrus.Fields, errMsg string) {
-	err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Content: errMsg,
-			Flags:   discordgo.MessageFlagsEphemeral,
-		},
-	})
+// MaxFieldLength is Discord's limit for the value of an embed field
+const MaxFieldLength = 1024
 
-	if err != nil {
-		(*logs)["error"] = err
-		logrus.WithFields(*logs).Error("unable to send message")
+func HandleStringDefault(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"*", `\*`,
+	"_", `\_`,
+	"~", `\~`,
+	"`", "\\`",
+	"|", `\|`,
+	">", `\>`,
+	"[", `\[`,
+	"]", `\]`,
+)
+
+// EscapeMarkdown stops user controlled text such as persona names from
+// being rendered as Discord markdown
+func EscapeMarkdown(value string) string {
+	return markdownEscaper.Replace(value)
+}
+
+// CommandOptions indexes the options of a (sub)command by name so handlers
+// can look up optional values without depending on their position
+func CommandOptions(options []*discordgo.ApplicationCommandInteractionDataOption) map[string]*discordgo.ApplicationCommandInteractionDataOption {
+	indexed := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, v := range options {
+		indexed[v.Name] = v
+	}
+	return indexed
+}
+
+// CommandPath returns the command and subcommand of an interaction
+//
+// Example: /player friends value:... -> "player friends"
+func CommandPath(interaction *discordgo.Interaction) string {
+	if interaction.Type != discordgo.InteractionApplicationCommand {
+		return ""
+	}
 
+	data := interaction.ApplicationCommandData()
+	for _, o := range data.Options {
+		if o.Type == discordgo.ApplicationCommandOptionSubCommand {
+			return data.Name + " " + o.Name
+		}
 	}
+	return data.Name
 }
 
-func HandleMessageOk(embMsg *discordgo.MessageEmbed, session *discordgo.Session, interaction *discordgo.InteractionCreate, logs *logrus.Fields) {
-	err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Embeds: []*discordgo.MessageEmbed{
-				embMsg,
-			},
-		},
-	})
+// InteractionUser returns the user who triggered the interaction, Member is
+// only set inside guilds and User only outside of them
+func InteractionUser(interaction *discordgo.Interaction) *discordgo.User {
+	if interaction.Member != nil && interaction.Member.User != nil {
+		return interaction.Member.User
+	}
+	return interaction.User
+}
 
+// ResolvePlayer resolves the player the user asked for and fetches their
+// summary
+func ResolvePlayer(ctx context.Context, steamClient steam.Steam, input string) (steam.ResolvedID, steam.Player, error) {
+	resolved, err := steamClient.ResolveSteamID(ctx, input)
 	if err != nil {
-		(*logs)["error"] = err
-		logrus.WithFields(*logs).Error("unable to send message")
+		return steam.ResolvedID{}, steam.Player{}, NewUserError(err, "unable to resolve player ID")
 	}
-}
 
-func HandleStringDefault(value string) string {
-	if value == "" {
-		return "-"
+	players, err := steamClient.PlayerSummaries(ctx, resolved.ID.String())
+	if err != nil {
+		return steam.ResolvedID{}, steam.Player{}, NewUserError(err, "unable to retrieve player summary")
 	}
-	return value
+
+	return resolved, players[0], nil
 }
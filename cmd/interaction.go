@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sirupsen/logrus"
+	"github.com/the-steam-hub/discord-bot/locale"
+)
+
+const componentIDSeparator = ":"
+
+// InteractionTimeout bounds a single handler. Interaction tokens expire
+// after 15 minutes, after which the response can no longer be edited.
+const InteractionTimeout = 14 * time.Minute
+
+// Handler answers a single interaction. Returning an error renders an
+// ephemeral error reply, see UserError.
+type Handler func(ctx context.Context, interaction *Interaction) error
+
+// Interaction wraps a Discord interaction with everything a handler needs
+// to answer it
+type Interaction struct {
+	*discordgo.InteractionCreate
+	Session *discordgo.Session
+	Logger  *logrus.Entry
+	Locale  locale.Locale
+	// Options of the invoked subcommand, indexed by name
+	Options map[string]*discordgo.ApplicationCommandInteractionDataOption
+
+	deferred bool
+}
+
+// Dispatch runs the handler for an interaction and renders the error it
+// returns, if any. The handler context derives from ctx and is cancelled
+// after InteractionTimeout.
+func Dispatch(ctx context.Context, session *discordgo.Session, interactionCreate *discordgo.InteractionCreate, h Handler) {
+	interaction := &Interaction{
+		InteractionCreate: interactionCreate,
+		Session:           session,
+		Logger:            logrus.NewEntry(logrus.StandardLogger()),
+		Locale:            locale.FromInteraction(interactionCreate.Interaction),
+		Options:           subcommandOptions(interactionCreate.Interaction),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, InteractionTimeout)
+	defer cancel()
+
+	err := h(ctx, interaction)
+	if err != nil {
+		interaction.respondError(err)
+	}
+}
+
+// OptionString returns the string value of the named option, or an empty
+// string when the option was not provided
+func (i *Interaction) OptionString(name string) string {
+	if v, ok := i.Options[name]; ok {
+		return v.StringValue()
+	}
+	return ""
+}
+
+// OptionInt returns the integer value of the named option, or def when the
+// option was not provided
+func (i *Interaction) OptionInt(name string, def int64) int64 {
+	if v, ok := i.Options[name]; ok {
+		return v.IntValue()
+	}
+	return def
+}
+
+// OptionBool returns the boolean value of the named option, or false when
+// the option was not provided
+func (i *Interaction) OptionBool(name string) bool {
+	if v, ok := i.Options[name]; ok {
+		return v.BoolValue()
+	}
+	return false
+}
+
+// ComponentArgs returns the arguments encoded in the custom ID of the
+// clicked component, see ComponentID
+func (i *Interaction) ComponentArgs() []string {
+	if i.Type != discordgo.InteractionMessageComponent {
+		return nil
+	}
+
+	parts := strings.Split(i.MessageComponentData().CustomID, componentIDSeparator)
+	return parts[1:]
+}
+
+// Defer acknowledges the interaction so the handler may take longer than
+// the three seconds Discord allows before a response must be sent
+func (i *Interaction) Defer() error {
+	responseType := discordgo.InteractionResponseDeferredChannelMessageWithSource
+	if i.Type == discordgo.InteractionMessageComponent {
+		responseType = discordgo.InteractionResponseDeferredMessageUpdate
+	}
+
+	err := i.Session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: responseType,
+	})
+	if err != nil {
+		return err
+	}
+
+	i.deferred = true
+	return nil
+}
+
+// Respond answers the interaction with an embed and optional components.
+// Component interactions update the message the component is attached to.
+func (i *Interaction) Respond(embMsg *discordgo.MessageEmbed, components ...discordgo.MessageComponent) error {
+	return i.respond([]*discordgo.MessageEmbed{embMsg}, nil, components)
+}
+
+// RespondFiles is Respond with attached files, embeds reference them with
+// attachment://<file name>
+func (i *Interaction) RespondFiles(embMsg *discordgo.MessageEmbed, files []*discordgo.File, components ...discordgo.MessageComponent) error {
+	return i.respond([]*discordgo.MessageEmbed{embMsg}, files, components)
+}
+
+// RespondEmbeds is Respond with several embeds, Discord shows at most 10
+func (i *Interaction) RespondEmbeds(embeds []*discordgo.MessageEmbed, components ...discordgo.MessageComponent) error {
+	return i.respond(embeds, nil, components)
+}
+
+func (i *Interaction) respond(embeds []*discordgo.MessageEmbed, files []*discordgo.File, components []discordgo.MessageComponent) error {
+	if i.deferred {
+		_, err := i.Session.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Embeds:     &embeds,
+			Components: &components,
+			Files:      files,
+		})
+		return err
+	}
+
+	responseType := discordgo.InteractionResponseChannelMessageWithSource
+	if i.Type == discordgo.InteractionMessageComponent {
+		responseType = discordgo.InteractionResponseUpdateMessage
+	}
+
+	return i.Session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: responseType,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     embeds,
+			Components: components,
+			Files:      files,
+		},
+	})
+}
+
+// respondError answers the interaction with an ephemeral error embed. A
+// deferred response is public, so it is replaced by an ephemeral follow up.
+// Deferred component updates keep the message the component belongs to.
+func (i *Interaction) respondError(err error) {
+	embMsg := errorEmbed(i.Locale, err)
+
+	if i.deferred {
+		if i.Type != discordgo.InteractionMessageComponent {
+			err = i.Session.InteractionResponseDelete(i.Interaction)
+		}
+		if err == nil {
+			_, err = i.Session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+				Embeds: []*discordgo.MessageEmbed{
+					embMsg,
+				},
+				Flags: discordgo.MessageFlagsEphemeral,
+			})
+		}
+	} else {
+		err = i.Session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Embeds: []*discordgo.MessageEmbed{
+					embMsg,
+				},
+				Flags: discordgo.MessageFlagsEphemeral,
+			},
+		})
+	}
+
+	if err != nil {
+		i.Logger.WithError(err).Error("unable to send message")
+	}
+}
+
+// ComponentID encodes a component name and its arguments in a custom ID,
+// custom IDs are limited to 100 characters
+func ComponentID(name string, args ...string) string {
+	return strings.Join(append([]string{name}, args...), componentIDSeparator)
+}
+
+// ComponentName returns the name of the clicked component, or an empty
+// string for other interactions
+func ComponentName(interaction *discordgo.Interaction) string {
+	if interaction.Type != discordgo.InteractionMessageComponent {
+		return ""
+	}
+
+	name, _, _ := strings.Cut(interaction.MessageComponentData().CustomID, componentIDSeparator)
+	return name
+}
+
+func subcommandOptions(interaction *discordgo.Interaction) map[string]*discordgo.ApplicationCommandInteractionDataOption {
+	if interaction.Type != discordgo.InteractionApplicationCommand {
+		return nil
+	}
+
+	for _, o := range interaction.ApplicationCommandData().Options {
+		if o.Type == discordgo.ApplicationCommandOptionSubCommand {
+			return CommandOptions(o.Options)
+		}
+	}
+	return CommandOptions(interaction.ApplicationCommandData().Options)
+}
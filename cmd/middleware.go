@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"runtime/debug"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sirupsen/logrus"
+	"github.com/the-steam-hub/discord-bot/lifecycle"
+	"github.com/the-steam-hub/discord-bot/logging"
+	"github.com/the-steam-hub/discord-bot/metrics"
+	"github.com/the-steam-hub/discord-bot/ratelimit"
+)
+
+// Middleware wraps a handler with behaviour shared between commands
+type Middleware func(next Handler) Handler
+
+// Chain wraps h with the middlewares, the first middleware runs first
+func Chain(h Handler, middlewares ...Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+// Log attaches a logger with a correlation ID to the interaction and logs
+// the error returned by the handler
+func Log() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, interaction *Interaction) error {
+			ctx, logger := logging.NewInteraction(ctx, interaction.Interaction, logrus.Fields{
+				"options": optionValues(interaction.Options),
+			})
+			interaction.Logger = logger
+
+			err := next(ctx, interaction)
+			if err != nil {
+				var userErr *UserError
+				if errors.As(err, &userErr) {
+					logger.WithError(userErr.Err).Error(fmt.Sprintf(userErr.Message, userErr.Args...))
+				} else {
+					logger.WithError(err).Error("command failed")
+				}
+			}
+			return err
+		}
+	}
+}
+
+// Recover turns a panicking handler into an error so a single command can
+// not take the bot down
+func Recover() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, interaction *Interaction) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					interaction.Logger.WithField("stack", string(debug.Stack())).Error("handler panicked")
+					err = fmt.Errorf("panic: %v", r)
+				}
+			}()
+			return next(ctx, interaction)
+		}
+	}
+}
+
+// Metrics records the latency of each command and counts failed ones
+func Metrics() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, interaction *Interaction) error {
+			start := time.Now()
+			err := next(ctx, interaction)
+			if err != nil {
+				metrics.CommandError(interaction.Interaction)
+			}
+			metrics.ObserveCommand(interaction.Interaction, start)
+			return err
+		}
+	}
+}
+
+// Drain rejects new commands once the bot is shutting down and lets the
+// manager wait for the ones in flight
+func Drain(manager *lifecycle.Manager) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, interaction *Interaction) error {
+			if !manager.Acquire() {
+				return NewUserError(nil, "the bot is restarting, please try again in a moment")
+			}
+			defer manager.Release()
+
+			return next(ctx, interaction)
+		}
+	}
+}
+
+// RateLimit enforces the command cooldowns and the cap on Steam heavy
+// commands, heavy commands wait at most wait for a free slot. Components
+// such as page buttons belong to a command that already passed, so they are
+// not limited.
+func RateLimit(limiter *ratelimit.Limiter, wait time.Duration) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, interaction *Interaction) error {
+			if interaction.Type == discordgo.InteractionMessageComponent {
+				return next(ctx, interaction)
+			}
+
+			command := CommandPath(interaction.Interaction)
+			user := InteractionUser(interaction.Interaction)
+
+			cooldown, ok := limiter.Allow(user.ID, interaction.GuildID, command)
+			if !ok {
+				interaction.Logger.WithField("wait", cooldown.String()).Warn("command rate limited")
+				return NewUserError(nil, "slow down! try again in %ds", int(math.Ceil(cooldown.Seconds())))
+			}
+
+			if !limiter.Heavy(command) {
+				return next(ctx, interaction)
+			}
+
+			waitCtx, cancel := context.WithTimeout(ctx, wait)
+			defer cancel()
+
+			if !limiter.Acquire(waitCtx) {
+				interaction.Logger.Warn("too many heavy commands in flight")
+				return NewUserError(nil, "the bot is busy, please try again in a moment")
+			}
+			defer limiter.Release()
+
+			return next(ctx, interaction)
+		}
+	}
+}
+
+// RequirePermissions only runs the handler when the member has all of the
+// permissions. Discord hides commands based on DefaultMemberPermissions but
+// server admins can override that per command.
+func RequirePermissions(permissions int64) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, interaction *Interaction) error {
+			member := interaction.Member
+			if member == nil || member.Permissions&permissions != permissions {
+				return NewUserError(nil, "you do not have permission to use this command")
+			}
+
+			return next(ctx, interaction)
+		}
+	}
+}
+
+// RequireInvoker only lets the user who ran the original command use the
+// components attached to its response
+func RequireInvoker() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, interaction *Interaction) error {
+			message := interaction.Message
+			if message != nil && message.Interaction != nil && message.Interaction.User != nil {
+				if message.Interaction.User.ID != InteractionUser(interaction.Interaction).ID {
+					return NewUserError(nil, "only the person who ran the command can use these buttons")
+				}
+			}
+
+			return next(ctx, interaction)
+		}
+	}
+}
+
+// Defer acknowledges the interaction before running slow handlers
+func Defer() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, interaction *Interaction) error {
+			err := interaction.Defer()
+			if err != nil {
+				return fmt.Errorf("unable to defer response: %w", err)
+			}
+
+			return next(ctx, interaction)
+		}
+	}
+}
+
+func optionValues(options map[string]*discordgo.ApplicationCommandInteractionDataOption) map[string]interface{} {
+	values := make(map[string]interface{}, len(options))
+	for k, v := range options {
+		values[k] = v.Value
+	}
+	return values
+}
@@ -1,88 +1,264 @@
 package player
 
 import (
+	"cmp"
+	"context"
+	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
 	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/locale"
 	"github.com/the-steam-hub/discord-bot/steam"
 )
 
-func PlayerBans(session *discordgo.Session, interaction *discordgo.InteractionCreate, steamClient steam.Steam, input string) {
-	logs := logrus.Fields{
-		"input":  input,
-		"author": interaction.Member.User.Username,
-		"uuid":   uuid.New(),
-	}
+const (
+	BansScopePlayer  = "player"
+	BansScopeFriends = "friends"
+	// Bans at most this many days ago count as recent
+	recentBanDays = 90
+	// Banned friends listed by name, the most recently banned first
+	maxBannedFriends = 15
+)
 
-	id, err := steamClient.ResolveSteamID(input)
+func PlayerBans(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string, scope string) error {
+	loc := interaction.Locale
+
+	resolved, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to resolve player ID"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return err
 	}
 
-	player, err := steamClient.PlayerSummaries(id)
-	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to retrieve player summary"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+	if scope == BansScopeFriends {
+		return playerFriendBans(ctx, interaction, steamClient, resolved, player)
 	}
 
-	err = steamClient.PlayerBans(&player[0])
+	err = steamClient.PlayerBans(ctx, &player)
 	if err != nil {
-		logs["error"] = err
-		logrus.WithFields(logs).Error("unable to retieve player ban information")
+		interaction.Logger.WithError(err).Error("unable to retieve player ban information")
 	}
 
 	embMsg := &discordgo.MessageEmbed{
-		Color: 0x66c0f4,
+		Color:  0x66c0f4,
+		Footer: resolvedFooter(loc, resolved),
 		Thumbnail: &discordgo.MessageEmbedThumbnail{
-			URL: player[0].AvatarFull,
+			URL: player.AvatarFull,
 		},
 		Author: &discordgo.MessageEmbedAuthor{
-			Name: fmt.Sprintf("%s %s", player[0].Status(), player[0].Name),
-			URL:  player[0].ProfileURL,
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
 		},
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "VAC Banned",
-				Value:  strconv.FormatBool(player[0].VACBanned),
+				Name:   loc.Sprintf("VAC Banned"),
+				Value:  strconv.FormatBool(player.VACBanned),
 				Inline: true,
 			},
 			{
-				Name:   "# Of VAC Bans",
-				Value:  strconv.Itoa(player[0].NumOfVacBans),
+				Name:   loc.Sprintf("# Of VAC Bans"),
+				Value:  strconv.Itoa(player.NumOfVacBans),
 				Inline: true,
 			},
 			{
-				Name:   "# Of Game Bans",
-				Value:  strconv.Itoa(player[0].NumOfGameBans),
+				Name:   loc.Sprintf("# Of Game Bans"),
+				Value:  strconv.Itoa(player.NumOfGameBans),
 				Inline: true,
 			},
 			{
-				Name:   "Days Since Last Ban",
-				Value:  fmt.Sprintf("%dd", player[0].DaysSinceLastBan),
+				Name:   loc.Sprintf("Days Since Last Ban"),
+				Value:  fmt.Sprintf("%dd", player.DaysSinceLastBan),
 				Inline: true,
 			},
 			{
-				Name:   "Community Banned",
-				Value:  strconv.FormatBool(player[0].CommunityBanned),
+				Name:   loc.Sprintf("Community Banned"),
+				Value:  strconv.FormatBool(player.CommunityBanned),
 				Inline: true,
 			},
 			{
-				Name:   "Economy Banned",
-				Value:  player[0].EconomyBan,
+				Name:   loc.Sprintf("Economy Banned"),
+				Value:  player.EconomyBan,
 				Inline: true,
 			},
 		},
 	}
-	cmd.HandleMessageOk(embMsg, session, interaction, &logs)
+	return interaction.Respond(embMsg)
+}
+
+// playerFriendBans summarizes the bans of everyone on the players friends
+// list, which admins use to vet players
+func playerFriendBans(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, resolved steam.ResolvedID, player steam.Player) error {
+	loc := interaction.Locale
+
+	embMsg := &discordgo.MessageEmbed{
+		Title:  loc.Sprintf("Friend Bans"),
+		Color:  0x66c0f4,
+		Footer: resolvedFooter(loc, resolved),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: player.AvatarFull,
+		},
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
+		},
+	}
+
+	friendsList, err := steamClient.FriendsList(ctx, player.SteamID)
+	if errors.Is(err, steam.ErrPrivateProfile) {
+		embMsg.Description = loc.Sprintf("Friends list is private.")
+		return interaction.Respond(embMsg)
+	}
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve friends list")
+	}
+
+	if len(friendsList) == 0 {
+		embMsg.Description = loc.Sprintf("This player has no friends on Steam.")
+		return interaction.Respond(embMsg)
+	}
+
+	bans, err := steamClient.BanStatuses(ctx, steam.FriendIDs(friendsList)...)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve friend bans")
+	}
+
+	var vac, game, community, economy, recent int
+	banned := []steam.BanStatus{}
+	for _, v := range bans {
+		if v.NumOfVacBans > 0 {
+			vac++
+		}
+		if v.NumOfGameBans > 0 {
+			game++
+		}
+		if v.CommunityBanned {
+			community++
+		}
+		if v.EconomyBan != "" && v.EconomyBan != "none" {
+			economy++
+		}
+		if !v.Banned() {
+			continue
+		}
+		if v.NumOfVacBans+v.NumOfGameBans > 0 && v.DaysSinceLastBan <= recentBanDays {
+			recent++
+		}
+		banned = append(banned, v)
+	}
+
+	// Community and trade bans have no date, so they are listed last
+	slices.SortStableFunc(banned, func(a, b steam.BanStatus) int {
+		return cmp.Compare(banAge(a), banAge(b))
+	})
+
+	bannedFriends := ""
+	listed := banned[:min(maxBannedFriends, len(banned))]
+	if len(listed) > 0 {
+		IDs := make([]string, len(listed))
+		for k, v := range listed {
+			IDs[k] = v.SteamID
+		}
+
+		players, err := steamClient.PlayerSummaries(ctx, IDs...)
+		if err != nil {
+			return cmd.NewUserError(err, "unable to retrieve friend summaries")
+		}
+
+		names := make(map[string]string, len(players))
+		for _, v := range players {
+			names[v.SteamID] = v.Name
+		}
+
+		shown := 0
+		for k, v := range listed {
+			line := fmt.Sprintf("**%s** · %s\n", cmd.HandleStringDefault(cmd.EscapeMarkdown(names[v.SteamID])), formatFriendBan(loc, v))
+			// Leave room for the "and N more" line
+			if len(bannedFriends)+len(line)+len(loc.Sprintf("and %d more", len(banned)-k)) > cmd.MaxFieldLength {
+				break
+			}
+			bannedFriends += line
+			shown++
+		}
+		if len(banned) > shown {
+			bannedFriends += loc.Sprintf("and %d more", len(banned)-shown)
+		}
+	}
+
+	percent := func(n int) string {
+		if len(bans) == 0 {
+			return strconv.Itoa(n)
+		}
+		return fmt.Sprintf("%d (%.1f%%)", n, float64(n)*100/float64(len(bans)))
+	}
+
+	embMsg.Fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   loc.Sprintf("Friends"),
+			Value:  strconv.Itoa(len(friendsList)),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("VAC Banned"),
+			Value:  percent(vac),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Game Banned"),
+			Value:  percent(game),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Community Banned"),
+			Value:  percent(community),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Economy Banned"),
+			Value:  percent(economy),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Banned In Last %d Days", recentBanDays),
+			Value:  percent(recent),
+			Inline: true,
+		},
+		{
+			Name:  loc.Sprintf("Banned Friends"),
+			Value: cmd.HandleStringDefault(bannedFriends),
+		},
+	}
+
+	return interaction.Respond(embMsg)
+}
+
+// banAge returns the days since the last VAC or game ban, bans without a
+// date are treated as the oldest
+func banAge(b steam.BanStatus) int {
+	if b.NumOfVacBans+b.NumOfGameBans == 0 {
+		return math.MaxInt
+	}
+	return b.DaysSinceLastBan
+}
+
+func formatFriendBan(loc locale.Locale, b steam.BanStatus) string {
+	parts := []string{}
+	if b.NumOfVacBans > 0 {
+		parts = append(parts, loc.Sprintf("%d VAC", b.NumOfVacBans))
+	}
+	if b.NumOfGameBans > 0 {
+		parts = append(parts, loc.Sprintf("%d game", b.NumOfGameBans))
+	}
+	if b.CommunityBanned {
+		parts = append(parts, loc.Sprintf("community"))
+	}
+	if b.EconomyBan != "" && b.EconomyBan != "none" {
+		parts = append(parts, loc.Sprintf("trade"))
+	}
+	if b.NumOfVacBans+b.NumOfGameBans > 0 {
+		parts = append(parts, loc.Sprintf("%dd ago", b.DaysSinceLastBan))
+	}
+	return strings.Join(parts, " · ")
 }
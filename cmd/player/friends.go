@@ -1,12 +1,15 @@
 package player
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"image/color"
 	"math"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
+	"github.com/the-steam-hub/discord-bot/chart"
 	"github.com/the-steam-hub/discord-bot/cmd"
 	"github.com/the-steam-hub/discord-bot/steam"
 )
@@ -20,52 +23,48 @@ type FriendData struct {
 	Player steam.Player
 }
 
-func PlayerFriends(session *discordgo.Session, interaction *discordgo.InteractionCreate, steamClient steam.Steam, input string) {
-	logs := logrus.Fields{
-		"input":  input,
-		"author": interaction.Member.User.Username,
-		"uuid":   uuid.New(),
-	}
+func PlayerFriends(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string) error {
+	loc := interaction.Locale
 
-	id, err := steamClient.ResolveSteamID(input)
+	resolved, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to resolve player ID"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return err
 	}
 
-	player, err := steamClient.PlayerSummaries(id)
-	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to retrieve player summary"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+	embMsg := &discordgo.MessageEmbed{
+		Color:  0x66c0f4,
+		Footer: resolvedFooter(loc, resolved),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: player.AvatarFull,
+		},
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
+		},
 	}
 
-	friendsList, err := steamClient.FriendsList(player[0].SteamID)
+	friendsList, err := steamClient.FriendsList(ctx, player.SteamID)
+	if errors.Is(err, steam.ErrPrivateProfile) {
+		embMsg.Description = loc.Sprintf("Friends list is private.")
+		return interaction.Respond(embMsg)
+	}
 	if err != nil {
-		logs["error"] = err
-		logrus.WithFields(logs).Error("unable to retrieve friends list")
+		return cmd.NewUserError(err, "unable to retrieve friends list")
 	}
 
 	// Sorting the friends list so we display the oldest friends first
 	sortedFriendsList := steam.FriendsSort(friendsList)
 	// Capping the friends list to avoid message overflow issues with Discord
 	sortedCappedFriendsList := sortedFriendsList[:int(math.Min(float64(len(sortedFriendsList)), cap))]
-	// Length may be zero if the players account is private
 	if len(sortedCappedFriendsList) > 0 {
 		// Assigning the newest friend to the last index. This allows us to grab the name of the newest friend in the same API call as the other 49 friends
 		sortedCappedFriendsList[len(sortedCappedFriendsList)-1] = sortedFriendsList[len(sortedFriendsList)-1]
 	}
 
 	// Getting player information for all friends within the cap range
-	players, err := steamClient.PlayerSummaries(steam.FriendIDs(sortedFriendsList)[:len(sortedCappedFriendsList)]...)
+	players, err := steamClient.PlayerSummaries(ctx, steam.FriendIDs(sortedFriendsList)[:len(sortedCappedFriendsList)]...)
 	if err != nil {
-		logs["error"] = err
-		logrus.WithFields(logs).Error("unable to retrieve player summary")
+		interaction.Logger.WithError(err).Error("unable to retrieve player summary")
 	}
 
 	// Friend data and Player data exists in two seperate API calls, and so, we need to tie the data together
@@ -84,7 +83,6 @@ func PlayerFriends(session *discordgo.Session, interaction *discordgo.Interactio
 
 	names, statuses, friendsSince, oldest, newest := "", "", "", "", ""
 
-	// Length may be zero if the players account is private
 	if len(sortedCappedFriendsList) > 0 {
 		oldest = sortedCappedFriendsList[0].ID
 		newest = sortedCappedFriendsList[len(sortedCappedFriendsList)-1].ID
@@ -107,50 +105,129 @@ func PlayerFriends(session *discordgo.Session, interaction *discordgo.Interactio
 		}
 	}
 
-	embMsg := &discordgo.MessageEmbed{
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Friend information is dependent upon the user's privacy settings.",
+	embMsg.Fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   loc.Sprintf("Newest"),
+			Value:  cmd.HandleStringDefault(newest),
+			Inline: true,
 		},
-		Color: 0x66c0f4,
-		Thumbnail: &discordgo.MessageEmbedThumbnail{
-			URL: player[0].AvatarFull,
+		{
+			Name:   loc.Sprintf("Oldest"),
+			Value:  cmd.HandleStringDefault(oldest),
+			Inline: true,
 		},
-		Author: &discordgo.MessageEmbedAuthor{
-			Name: fmt.Sprintf("%s %s", player[0].Status(), player[0].Name),
-			URL:  player[0].ProfileURL,
+		{
+			Name:   loc.Sprintf("Count"),
+			Value:  fmt.Sprintf("%d", len(sortedFriendsList)),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Top 50 Friends"),
+			Value:  cmd.HandleStringDefault(names),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Friends For"),
+			Value:  cmd.HandleStringDefault(friendsSince),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Status"),
+			Value:  cmd.HandleStringDefault(statuses),
+			Inline: true,
+		},
+	}
+
+	years, counts := friendsTimeline(friendsList)
+	if len(years) == 0 {
+		return interaction.Respond(embMsg)
+	}
+
+	perYear := ""
+	for k, year := range years {
+		perYear += fmt.Sprintf("`%d` %d\n", year, counts[k])
+	}
+	if undated := len(friendsList) - sum(counts); undated > 0 {
+		perYear += loc.Sprintf("%d without a date", undated)
+	}
+
+	busiest, busiestCount := steam.FriendsBusiestMonth(friendsList)
+	embMsg.Fields = append(embMsg.Fields,
+		&discordgo.MessageEmbedField{
+			Name:   loc.Sprintf("Friends Added Per Year"),
+			Value:  perYear,
+			Inline: true,
 		},
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "Newest",
-				Value:  cmd.HandleStringDefault(newest),
-				Inline: true,
-			},
-			{
-				Name:   "Oldest",
-				Value:  cmd.HandleStringDefault(oldest),
-				Inline: true,
-			},
-			{
-				Name:   "Count",
-				Value:  fmt.Sprintf("%d", len(sortedFriendsList)),
-				Inline: true,
-			},
-			{
-				Name:   "Top 50 Friends",
-				Value:  cmd.HandleStringDefault(names),
-				Inline: true,
-			},
-			{
-				Name:   "Friends For",
-				Value:  cmd.HandleStringDefault(friendsSince),
-				Inline: true,
-			},
-			{
-				Name:   "Status",
-				Value:  cmd.HandleStringDefault(statuses),
-				Inline: true,
-			},
+		&discordgo.MessageEmbedField{
+			Name:   loc.Sprintf("Busiest Month"),
+			Value:  loc.Sprintf("%s (%d friends)", busiest.Format("2006-01"), busiestCount),
+			Inline: true,
 		},
+	)
+
+	chartPNG, err := friendsChart(counts)
+	if err != nil {
+		interaction.Logger.WithError(err).Error("unable to render friends chart")
+		return interaction.Respond(embMsg)
+	}
+
+	embMsg.Image = &discordgo.MessageEmbedImage{
+		URL: "attachment://" + friendsChartName,
+	}
+	return interaction.RespondFiles(embMsg, []*discordgo.File{
+		{
+			Name:        friendsChartName,
+			ContentType: "image/png",
+			Reader:      bytes.NewReader(chartPNG),
+		},
+	})
+}
+
+const (
+	friendsChartName   = "friends.png"
+	friendsChartWidth  = 512
+	friendsChartHeight = 160
+)
+
+// friendsTimeline returns every year from the first to the last friend
+// added and how many friends were added in it, including empty years
+func friendsTimeline(friends []steam.Friend) ([]int, []int) {
+	perYear := steam.FriendsPerYear(friends)
+	if len(perYear) == 0 {
+		return nil, nil
+	}
+
+	first, last := math.MaxInt, 0
+	for year := range perYear {
+		first = min(first, year)
+		last = max(last, year)
+	}
+
+	years := []int{}
+	counts := []int{}
+	for year := first; year <= last; year++ {
+		years = append(years, year)
+		counts = append(counts, perYear[year])
+	}
+	return years, counts
+}
+
+// friendsChart renders the friends added per year as a bar chart
+func friendsChart(counts []int) ([]byte, error) {
+	bars := make([]chart.Slice, len(counts))
+	for k, v := range counts {
+		bars[k] = chart.Slice{
+			Value: float64(v),
+			Color: color.RGBA{0x66, 0xc0, 0xf4, 0xff},
+		}
+	}
+	return chart.PNG(chart.Bar(bars, friendsChartWidth, friendsChartHeight))
+}
+
+func sum(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
 	}
-	cmd.HandleMessageOk(embMsg, session, interaction, &logs)
+	return total
 }
@@ -1,118 +1,224 @@
 package player
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"image/color"
 	"strconv"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
+	"github.com/the-steam-hub/discord-bot/chart"
 	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/locale"
 	"github.com/the-steam-hub/discord-bot/steam"
 )
 
-func PlayerGames(session *discordgo.Session, interaction *discordgo.InteractionCreate, steamClient steam.Steam, input string) {
-	logs := logrus.Fields{
-		"input":  input,
-		"author": interaction.Member.User.Username,
-		"uuid":   uuid.New(),
-	}
+func PlayerGames(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string) error {
+	loc := interaction.Locale
 
-	id, err := steamClient.ResolveSteamID(input)
+	resolved, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to resolve player ID"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return err
 	}
 
-	player, err := steamClient.PlayerSummaries(id)
-	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to retrieve player summary"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+	embMsg := &discordgo.MessageEmbed{
+		Color:  0x66c0f4,
+		Footer: resolvedFooter(loc, resolved),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: player.AvatarFull,
+		},
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
+		},
 	}
 
-	ownedApps, err := steamClient.AppsOwned(player[0].SteamID)
+	ownedApps, err := steamClient.AppsOwned(ctx, player.SteamID)
+	if errors.Is(err, steam.ErrPrivateProfile) {
+		embMsg.Description = loc.Sprintf("Game details are private.")
+		return interaction.Respond(embMsg)
+	}
 	if err != nil {
-		logs["error"] = err
-		logrus.WithFields(logs).Error("unable to retrieve owned games")
+		return cmd.NewUserError(err, "unable to retrieve owned games")
 	}
 
-	recentApps, err := steamClient.AppsRecentlyPlayed(player[0].SteamID)
+	recentApps, err := steamClient.AppsRecentlyPlayed(ctx, player.SteamID)
 	if err != nil {
-		logs["error"] = err
-		logrus.WithFields(logs).Error("unable to retireve recently played games")
+		interaction.Logger.WithError(err).Error("unable to retireve recently played games")
+		recentApps = &[]steam.AppPlayTime{}
 	}
 
 	mostPlayed, _ := steam.AppsMostPlayed(*ownedApps)
 	leastPlayed, _ := steam.AppsLeastPlayed(*ownedApps)
 
-	embMsg := &discordgo.MessageEmbed{
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Game information is dependent upon the user's privacy settings.",
+	embMsg.Fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   loc.Sprintf("Total Playtime"),
+			Value:  fmt.Sprintf("%dh", steam.AppsTotalHoursPlayed(*ownedApps)),
+			Inline: true,
 		},
-		Color: 0x66c0f4,
-		Thumbnail: &discordgo.MessageEmbedThumbnail{
-			URL: player[0].AvatarFull,
+		{
+			Name:   loc.Sprintf("Most Played Game"),
+			Value:  DefaultAppValue(mostPlayed),
+			Inline: true,
 		},
-		Author: &discordgo.MessageEmbedAuthor{
-			Name: fmt.Sprintf("%s %s", player[0].Status(), player[0].Name),
-			URL:  player[0].ProfileURL,
+		{
+			Name:   loc.Sprintf("Least Played Game"),
+			Value:  DefaultAppValue(leastPlayed),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Games Owned"),
+			Value:  strconv.Itoa(len(*ownedApps)),
+			Inline: true,
 		},
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "Total Playtime",
-				Value:  fmt.Sprintf("%dh", steam.AppsTotalHoursPlayed(*ownedApps)),
-				Inline: true,
-			},
-			{
-				Name:   "Most Played Game",
-				Value:  DefaultAppValue(mostPlayed),
-				Inline: true,
-			},
-			{
-				Name:   "Least Played Game",
-				Value:  DefaultAppValue(leastPlayed),
-				Inline: true,
-			},
-			{
-				Name:   "Games Owned",
-				Value:  strconv.Itoa(len(*ownedApps)),
-				Inline: true,
-			},
-			{
-				Name:   "Games Played",
-				Value:  strconv.Itoa(len(steam.AppsPlayed(*ownedApps))),
-				Inline: true,
-			},
-			{
-				Name:   "Games Not Played",
-				Value:  strconv.Itoa(len(steam.AppsNotPlayed(*ownedApps))),
-				Inline: true,
-			},
-			{
-				Name:   "Recent Playtime",
-				Value:  fmt.Sprintf("%dh", steam.AppsRecentHoursPlayed(*ownedApps)),
-				Inline: true,
-			},
-			{
-				Name:   "Recent Games Played",
-				Value:  strconv.Itoa(len(*recentApps)),
-				Inline: true,
-			},
-			{
-				Name:   "",
-				Value:  "",
-				Inline: true,
-			},
+		{
+			Name:   loc.Sprintf("Games Played"),
+			Value:  strconv.Itoa(len(steam.AppsPlayed(*ownedApps))),
+			Inline: true,
 		},
+		{
+			Name:   loc.Sprintf("Games Not Played"),
+			Value:  strconv.Itoa(len(steam.AppsNotPlayed(*ownedApps))),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Recent Playtime"),
+			Value:  fmt.Sprintf("%dh", steam.AppsRecentHoursPlayed(*recentApps)),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Recent Games Played"),
+			Value:  strconv.Itoa(len(*recentApps)),
+			Inline: true,
+		},
+		{
+			Name:   "",
+			Value:  "",
+			Inline: true,
+		},
+	}
+
+	platformTimes := steam.AppsPlayTimeByPlatform(*ownedApps)
+	embMsg.Fields = append(embMsg.Fields,
+		&discordgo.MessageEmbedField{
+			Name:  loc.Sprintf("Platforms"),
+			Value: cmd.HandleStringDefault(platformBreakdown(loc, platformTimes)),
+		},
+		&discordgo.MessageEmbedField{
+			Name:   loc.Sprintf("Top Steam Deck Games"),
+			Value:  cmd.HandleStringDefault(topPlatformGames(*ownedApps, steam.PlatformDeck)),
+			Inline: true,
+		},
+		&discordgo.MessageEmbedField{
+			Name:   loc.Sprintf("Top Linux Games"),
+			Value:  cmd.HandleStringDefault(topPlatformGames(*ownedApps, steam.PlatformLinux)),
+			Inline: true,
+		},
+	)
+
+	chartPNG, err := platformChart(platformTimes)
+	if err != nil {
+		interaction.Logger.WithError(err).Error("unable to render platform chart")
+	}
+	if chartPNG == nil {
+		return interaction.Respond(embMsg)
+	}
+
+	embMsg.Image = &discordgo.MessageEmbedImage{
+		URL: "attachment://" + platformChartName,
+	}
+	return interaction.RespondFiles(embMsg, []*discordgo.File{
+		{
+			Name:        platformChartName,
+			ContentType: "image/png",
+			Reader:      bytes.NewReader(chartPNG),
+		},
+	})
+}
+
+const (
+	platformChartName    = "platforms.png"
+	platformChartSize    = 256
+	topPlatformGameCount = 3
+)
+
+// Colours of the pie chart slices, matching the square emoji shown next to
+// each platform in the breakdown
+var platformColors = map[steam.Platform]struct {
+	Emoji string
+	Color color.RGBA
+}{
+	steam.PlatformWindows: {"🟦", color.RGBA{0x55, 0xac, 0xee, 0xff}},
+	steam.PlatformMac:     {"⬜", color.RGBA{0xe6, 0xe7, 0xe8, 0xff}},
+	steam.PlatformLinux:   {"🟧", color.RGBA{0xf4, 0x90, 0x0c, 0xff}},
+	steam.PlatformDeck:    {"🟪", color.RGBA{0xaa, 0x8e, 0xd6, 0xff}},
+}
+
+func platformName(loc locale.Locale, platform steam.Platform) string {
+	switch platform {
+	case steam.PlatformWindows:
+		return loc.Sprintf("Windows")
+	case steam.PlatformMac:
+		return loc.Sprintf("macOS")
+	case steam.PlatformLinux:
+		return loc.Sprintf("Linux")
+	case steam.PlatformDeck:
+		return loc.Sprintf("Steam Deck")
+	}
+	return string(platform)
+}
+
+// platformBreakdown lists the hours played on each platform and their share
+// of the playtime Steam attributed to a platform
+func platformBreakdown(loc locale.Locale, platformTimes map[steam.Platform]int) string {
+	total := 0
+	for _, minutes := range platformTimes {
+		total += minutes
+	}
+	if total == 0 {
+		return ""
+	}
+
+	breakdown := ""
+	for _, platform := range steam.Platforms {
+		minutes := platformTimes[platform]
+		if minutes == 0 {
+			continue
+		}
+		breakdown += fmt.Sprintf("%s %s · %s · %.1f%%\n", platformColors[platform].Emoji, platformName(loc, platform),
+			formatPlaytime(minutes), float64(minutes)*100/float64(total))
+	}
+	return breakdown
+}
+
+func topPlatformGames(apps []steam.AppPlayTime, platform steam.Platform) string {
+	games := ""
+	for _, v := range steam.AppsMostPlayedOn(apps, platform, topPlatformGameCount) {
+		games += fmt.Sprintf("%s · %s\n", v.Name, formatPlaytime(v.PlayTimeOn(platform)))
+	}
+	return games
+}
+
+// platformChart renders the platform breakdown as a pie chart, it returns
+// nil when no playtime was attributed to a platform
+func platformChart(platformTimes map[steam.Platform]int) ([]byte, error) {
+	slices := []chart.Slice{}
+	for _, platform := range steam.Platforms {
+		if platformTimes[platform] > 0 {
+			slices = append(slices, chart.Slice{
+				Value: float64(platformTimes[platform]),
+				Color: platformColors[platform].Color,
+			})
+		}
+	}
+	if len(slices) == 0 {
+		return nil, nil
 	}
 
-	cmd.HandleMessageOk(embMsg, session, interaction, &logs)
+	return chart.PNG(chart.Pie(slices, platformChartSize))
 }
 
 func DefaultAppValue(value *steam.AppPlayTime) string {
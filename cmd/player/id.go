@@ -1,69 +1,67 @@
 package player
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
 	"github.com/the-steam-hub/discord-bot/cmd"
 	"github.com/the-steam-hub/discord-bot/steam"
 )
 
-func PlayerID(session *discordgo.Session, interaction *discordgo.InteractionCreate, steamClient steam.Steam, input string) {
-	logs := logrus.Fields{
-		"input":  input,
-		"author": interaction.Member.User.Username,
-		"uuid":   uuid.New(),
-	}
-
-	id, err := steamClient.ResolveSteamID(input)
-	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to resolve player ID"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
-	}
+func PlayerID(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string) error {
+	loc := interaction.Locale
 
-	player, err := steamClient.PlayerSummaries(id)
+	resolved, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to retrieve player summary"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return err
 	}
 
-	steamIDInt, _ := strconv.ParseUint(player[0].SteamID, 10, 64)
+	steamID := resolved.ID
 
 	embMsg := &discordgo.MessageEmbed{
-		Color: 0x66c0f4,
+		Color:  0x66c0f4,
+		Footer: resolvedFooter(loc, resolved),
 		Thumbnail: &discordgo.MessageEmbedThumbnail{
-			URL: player[0].AvatarFull,
+			URL: player.AvatarFull,
 		},
 		Author: &discordgo.MessageEmbedAuthor{
-			Name: fmt.Sprintf("%s %s", player[0].Status(), player[0].Name),
-			URL:  player[0].ProfileURL,
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
 		},
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "Steam ID",
-				Value:  cmd.HandleStringDefault(steam.SteamID64ToSteamID(steamIDInt)),
+				Name:   loc.Sprintf("Steam ID"),
+				Value:  steamID.SteamID2(),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Steam ID3"),
+				Value:  steamID.SteamID3(),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Steam ID64"),
+				Value:  steamID.String(),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Account ID"),
+				Value:  strconv.FormatUint(uint64(steamID.AccountID()), 10),
 				Inline: true,
 			},
 			{
-				Name:   "Steam ID3",
-				Value:  cmd.HandleStringDefault(steam.SteamID64ToSteamID3(steamIDInt)),
+				Name:   loc.Sprintf("Friend Code"),
+				Value:  cmd.HandleStringDefault(steamID.FriendCode()),
 				Inline: true,
 			},
 			{
-				Name:   "Steam ID64",
-				Value:  cmd.HandleStringDefault(player[0].SteamID),
+				Name:   loc.Sprintf("Invite Link"),
+				Value:  steamID.InviteURL(),
 				Inline: true,
 			},
 		},
 	}
-	cmd.HandleMessageOk(embMsg, session, interaction, &logs)
+	return interaction.Respond(embMsg)
 }
@@ -0,0 +1,172 @@
+package player
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/steam"
+)
+
+const (
+	// Custom ID name of the library page buttons
+	LibraryComponent = "library"
+	libraryPageSize  = 15
+)
+
+// Values of the sort option of /player library
+const (
+	LibrarySortPlaytime = "playtime"
+	LibrarySortName     = "name"
+	LibrarySortRecent   = "recent"
+)
+
+// Values of the filter option of /player library besides the platforms
+const (
+	LibraryFilterPlayed   = "played"
+	LibraryFilterUnplayed = "unplayed"
+)
+
+func PlayerLibrary(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string, sortBy string, filter string) error {
+	resolved, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
+	if err != nil {
+		return err
+	}
+
+	return playerLibraryPage(ctx, interaction, steamClient, resolved, player, sortBy, filter, 1)
+}
+
+// PlayerLibraryPage answers the library page buttons, their custom ID holds
+// the player, how the input was resolved, the sort, the filter and the page
+func PlayerLibraryPage(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam) error {
+	args := interaction.ComponentArgs()
+	if len(args) != 5 {
+		return fmt.Errorf("unexpected library component arguments %q", args)
+	}
+
+	source, _ := strconv.Atoi(args[1])
+	page, _ := strconv.Atoi(args[4])
+
+	resolved, player, err := cmd.ResolvePlayer(ctx, steamClient, args[0])
+	if err != nil {
+		return err
+	}
+	resolved.Source = steam.IDSource(source)
+
+	return playerLibraryPage(ctx, interaction, steamClient, resolved, player, args[2], args[3], max(page, 1))
+}
+
+func playerLibraryPage(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, resolved steam.ResolvedID, player steam.Player, sortBy string, filter string, page int) error {
+	loc := interaction.Locale
+
+	embMsg := &discordgo.MessageEmbed{
+		Color:  0x66c0f4,
+		Footer: resolvedFooter(loc, resolved),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: player.AvatarFull,
+		},
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
+		},
+	}
+
+	ownedApps, err := steamClient.AppsOwned(ctx, player.SteamID)
+	if errors.Is(err, steam.ErrPrivateProfile) {
+		embMsg.Description = loc.Sprintf("Game details are private.")
+		return interaction.Respond(embMsg)
+	}
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve owned games")
+	}
+
+	apps := filterLibrary(*ownedApps, filter)
+	sortLibrary(apps, sortBy)
+
+	pages := max((len(apps)+libraryPageSize-1)/libraryPageSize, 1)
+	page = min(page, pages)
+	shown := apps[(page-1)*libraryPageSize : min(page*libraryPageSize, len(apps))]
+
+	lines := make([]string, len(shown))
+	for k, v := range shown {
+		line := fmt.Sprintf("**%s** · %s", cmd.EscapeMarkdown(v.Name), formatPlaytime(v.PlayTimeForever))
+		if v.PlayTime2Weeks > 0 {
+			line += " · " + loc.Sprintf("%s in the last 2 weeks", formatPlaytime(v.PlayTime2Weeks))
+		}
+		lines[k] = line
+	}
+
+	embMsg.Title = loc.Sprintf("Library (%d games)", len(apps))
+	embMsg.Description = cmd.HandleStringDefault(strings.Join(lines, "\n"))
+	embMsg.Footer = resolvedFooter(loc, resolved, loc.Sprintf("Page %d of %d", page, pages))
+
+	componentID := func(page int) string {
+		return cmd.ComponentID(LibraryComponent, player.SteamID, strconv.Itoa(int(resolved.Source)), sortBy, filter, strconv.Itoa(page))
+	}
+
+	buttons := discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    loc.Sprintf("Previous"),
+				Style:    discordgo.SecondaryButton,
+				CustomID: componentID(page - 1),
+				Disabled: page <= 1,
+			},
+			discordgo.Button{
+				Label:    loc.Sprintf("Next"),
+				Style:    discordgo.SecondaryButton,
+				CustomID: componentID(page + 1),
+				Disabled: page >= pages,
+			},
+		},
+	}
+
+	return interaction.Respond(embMsg, buttons)
+}
+
+func filterLibrary(apps []steam.AppPlayTime, filter string) []steam.AppPlayTime {
+	switch filter {
+	case LibraryFilterPlayed:
+		return steam.AppsPlayed(apps)
+	case LibraryFilterUnplayed:
+		return steam.AppsNotPlayed(apps)
+	case "":
+		return apps
+	}
+	return steam.AppsPlayedOn(apps, steam.Platform(filter))
+}
+
+func sortLibrary(apps []steam.AppPlayTime, sortBy string) {
+	byName := func(a, b steam.AppPlayTime) int {
+		return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	}
+
+	switch sortBy {
+	case LibrarySortName:
+		slices.SortStableFunc(apps, byName)
+	case LibrarySortRecent:
+		slices.SortStableFunc(apps, func(a, b steam.AppPlayTime) int {
+			return cmp.Or(cmp.Compare(b.PlayTime2Weeks, a.PlayTime2Weeks), cmp.Compare(b.PlayTimeForever, a.PlayTimeForever), byName(a, b))
+		})
+	default:
+		slices.SortStableFunc(apps, func(a, b steam.AppPlayTime) int {
+			return cmp.Or(cmp.Compare(b.PlayTimeForever, a.PlayTimeForever), byName(a, b))
+		})
+	}
+}
+
+// formatPlaytime formats minutes as hours and minutes
+//
+// Example: 754 -> 12h 34m
+func formatPlaytime(minutes int) string {
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %dm", minutes/60, minutes%60)
+}
@@ -0,0 +1,191 @@
+package player
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/steam"
+)
+
+const (
+	// Mutual friends listed by name, those both players know the longest first
+	maxMutuals = 20
+)
+
+type mutualFriend struct {
+	ID string
+	// When each of the two players became friends with them
+	FirstSince  int64
+	SecondSince int64
+}
+
+func PlayerMutuals(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string, otherInput string) error {
+	loc := interaction.Locale
+
+	resolved, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
+	if err != nil {
+		return err
+	}
+
+	_, other, err := cmd.ResolvePlayer(ctx, steamClient, otherInput)
+	if err != nil {
+		return err
+	}
+
+	if player.SteamID == other.SteamID {
+		return cmd.NewUserError(nil, "pick two different players")
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title:  loc.Sprintf("Mutual Friends"),
+		Color:  0x66c0f4,
+		Footer: resolvedFooter(loc, resolved),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: other.AvatarFull,
+		},
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
+		},
+	}
+
+	firstFriends, err := steamClient.FriendsList(ctx, player.SteamID)
+	if errors.Is(err, steam.ErrPrivateProfile) {
+		embMsg.Description = loc.Sprintf("The friends list of %s is private.", player.Name)
+		return interaction.Respond(embMsg)
+	}
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve friends list")
+	}
+
+	secondFriends, err := steamClient.FriendsList(ctx, other.SteamID)
+	if errors.Is(err, steam.ErrPrivateProfile) {
+		embMsg.Description = loc.Sprintf("The friends list of %s is private.", other.Name)
+		return interaction.Respond(embMsg)
+	}
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve friends list")
+	}
+
+	firstSince := make(map[string]int64, len(firstFriends))
+	for _, v := range firstFriends {
+		firstSince[v.ID] = v.FriendsSince
+	}
+
+	mutuals := []mutualFriend{}
+	for _, v := range secondFriends {
+		if since, ok := firstSince[v.ID]; ok {
+			mutuals = append(mutuals, mutualFriend{
+				ID:          v.ID,
+				FirstSince:  since,
+				SecondSince: v.FriendsSince,
+			})
+		}
+	}
+
+	slices.SortStableFunc(mutuals, func(a, b mutualFriend) int {
+		return cmp.Compare(max(a.FirstSince, a.SecondSince), max(b.FirstSince, b.SecondSince))
+	})
+
+	now := time.Now()
+	embMsg.Description = loc.Sprintf("**%s** and **%s** have %d mutual friends.", cmd.EscapeMarkdown(player.Name), cmd.EscapeMarkdown(other.Name), len(mutuals))
+	if since, ok := firstSince[other.SteamID]; ok {
+		embMsg.Description += "\n" + loc.Sprintf("They have been friends for %s.", formatFriendsFor(since, now))
+	}
+
+	names, firstFor, secondFor := "", "", ""
+	listed := mutuals[:min(maxMutuals, len(mutuals))]
+	if len(listed) > 0 {
+		IDs := make([]string, len(listed))
+		for k, v := range listed {
+			IDs[k] = v.ID
+		}
+
+		players, err := steamClient.PlayerSummaries(ctx, IDs...)
+		if err != nil {
+			interaction.Logger.WithError(err).Error("unable to retrieve player summary")
+		}
+
+		playerNames := make(map[string]string, len(players))
+		for _, v := range players {
+			playerNames[v.SteamID] = v.Name
+		}
+
+		for _, v := range listed {
+			names += fmt.Sprintf("%s\n", cmd.HandleStringDefault(cmd.EscapeMarkdown(playerNames[v.ID])))
+			firstFor += fmt.Sprintf("%s\n", formatFriendsFor(v.FirstSince, now))
+			secondFor += fmt.Sprintf("%s\n", formatFriendsFor(v.SecondSince, now))
+		}
+	}
+
+	// Overlap is the share of all friends of either player that both have
+	union := len(firstFriends) + len(secondFriends) - len(mutuals)
+	overlap := 0.0
+	if union > 0 {
+		overlap = float64(len(mutuals)) * 100 / float64(union)
+	}
+
+	embMsg.Fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   loc.Sprintf("Mutual Friends"),
+			Value:  fmt.Sprintf("%d", len(mutuals)),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Overlap"),
+			Value:  fmt.Sprintf("%.1f%%", overlap),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Friends"),
+			Value:  fmt.Sprintf("%d · %d", len(firstFriends), len(secondFriends)),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Top %d Mutual Friends", maxMutuals),
+			Value:  cmd.HandleStringDefault(names),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Friends Of %s For", cmd.EscapeMarkdown(player.Name)),
+			Value:  cmd.HandleStringDefault(firstFor),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Friends Of %s For", cmd.EscapeMarkdown(other.Name)),
+			Value:  cmd.HandleStringDefault(secondFor),
+			Inline: true,
+		},
+	}
+
+	return interaction.Respond(embMsg)
+}
+
+// formatFriendsFor returns how long ago a friendship started in years and
+// months, or days for friendships younger than a month. Friendships from
+// before Steam recorded the date have no since and show as unknown.
+//
+// Example: 2015-03-01 on 2024-07-15 -> 9y 4m
+func formatFriendsFor(since int64, now time.Time) string {
+	if since == 0 {
+		return "-"
+	}
+
+	start := time.Unix(since, 0).UTC()
+	now = now.UTC()
+	months := (now.Year()-start.Year())*12 + int(now.Month()-start.Month())
+	if now.Day() < start.Day() {
+		months--
+	}
+
+	if months < 1 {
+		return fmt.Sprintf("%dd", max(0, int(now.Sub(start).Hours()/24)))
+	}
+	return fmt.Sprintf("%dy %dm", months/12, months%12)
+}
@@ -0,0 +1,38 @@
+package player
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatFriendsFor(t *testing.T) {
+	now := time.Date(2024, time.July, 15, 12, 0, 0, 0, time.UTC)
+	since := func(year int, month time.Month, day int) int64 {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.UTC).Unix()
+	}
+
+	tests := []struct {
+		name  string
+		since int64
+		want  string
+	}{
+		{"unknown", 0, "-"},
+		{"today", since(2024, time.July, 15), "0d"},
+		{"days", since(2024, time.July, 1), "14d"},
+		{"day before a month", since(2024, time.June, 16), "29d"},
+		{"one month", since(2024, time.June, 15), "0y 1m"},
+		{"months", since(2023, time.July, 20), "0y 11m"},
+		{"one year", since(2023, time.July, 15), "1y 0m"},
+		{"years and months", since(2015, time.March, 1), "9y 4m"},
+		{"day of month not yet reached", since(2015, time.March, 20), "9y 3m"},
+		{"future", since(2024, time.August, 1), "0d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatFriendsFor(tt.since, now); got != tt.want {
+				t.Errorf("formatFriendsFor(%d) = %q, want %q", tt.since, got, tt.want)
+			}
+		})
+	}
+}
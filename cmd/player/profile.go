@@ -1,112 +1,177 @@
 package player
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
 	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/locale"
 	"github.com/the-steam-hub/discord-bot/steam"
 )
 
-func PlayerProfile(session *discordgo.Session, interaction *discordgo.InteractionCreate, steamClient steam.Steam, input string) {
-	logs := logrus.Fields{
-		"input":  input,
-		"author": interaction.Member.User.Username,
-		"uuid":   uuid.New(),
-	}
-
-	id, err := steamClient.ResolveSteamID(input)
-	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to resolve player ID"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
-	}
+func PlayerProfile(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string) error {
+	loc := interaction.Locale
 
-	player, err := steamClient.PlayerSummaries(id)
+	resolved, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
 	if err != nil {
-		logs["error"] = err
-		errMsg := "unable to retrieve player summary"
-		logrus.WithFields(logs).Error(errMsg)
-		cmd.HandleMessageError(session, interaction, &logs, errMsg)
-		return
+		return err
 	}
 
-	err = steamClient.PlayerBadges(&player[0])
+	err = steamClient.PlayerBadges(ctx, &player)
 	if err != nil {
-		logs["error"] = err
-		logrus.WithFields(logs).Error("unable to retireve player badges")
+		interaction.Logger.WithError(err).Error("unable to retireve player badges")
 	}
 
-	err = steamClient.PlayerLevelDistribution(&player[0])
+	err = steamClient.PlayerLevelDistribution(ctx, &player)
 	if err != nil {
-		logs["error"] = err
-		logrus.WithFields(logs).Error("unable to retireve player level distribution")
+		interaction.Logger.WithError(err).Error("unable to retireve player level distribution")
 	}
 
 	embMsg := &discordgo.MessageEmbed{
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Profile information is dependent upon the user's privacy settings.",
-		},
-		Color: 0x66c0f4,
+		Color:  0x66c0f4,
+		Footer: resolvedFooter(loc, resolved),
 		Thumbnail: &discordgo.MessageEmbedThumbnail{
-			URL: player[0].AvatarFull,
+			URL: player.AvatarFull,
 		},
 		Author: &discordgo.MessageEmbedAuthor{
-			Name: fmt.Sprintf("%s %s", player[0].Status(), player[0].Name),
-			URL:  player[0].ProfileURL,
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
 		},
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "Real Name",
-				Value:  cmd.HandleStringDefault(player[0].RealName),
+				Name:   loc.Sprintf("Real Name"),
+				Value:  cmd.HandleStringDefault(player.RealName),
 				Inline: true,
 			},
 			{
-				Name:   "Country Code",
-				Value:  cmd.HandleStringDefault(player[0].CountryCode),
+				Name:   loc.Sprintf("Country Code"),
+				Value:  cmd.HandleStringDefault(player.CountryCode),
 				Inline: true,
 			},
 			{
-				Name:   "State Code",
-				Value:  cmd.HandleStringDefault(player[0].StateCode),
+				Name:   loc.Sprintf("State Code"),
+				Value:  cmd.HandleStringDefault(player.StateCode),
 				Inline: true,
 			},
 			{
-				Name:   "Profile Age",
-				Value:  cmd.HandleStringDefault(player[0].ProfileAge()),
+				Name:   loc.Sprintf("Profile Age"),
+				Value:  cmd.HandleStringDefault(player.ProfileAge()),
 				Inline: true,
 			},
 			{
-				Name:   "Last Seen",
-				Value:  cmd.HandleStringDefault(player[0].LastSeen()),
+				Name:   loc.Sprintf("Last Seen"),
+				Value:  cmd.HandleStringDefault(player.LastSeen()),
 				Inline: true,
 			},
 			{
-				Name:   "Level",
-				Value:  strconv.Itoa(player[0].PlayerLevel),
+				Name:   loc.Sprintf("Level"),
+				Value:  strconv.Itoa(player.PlayerLevel),
 				Inline: true,
 			},
 			{
-				Name:   "Level Percentile",
-				Value:  strconv.FormatFloat(player[0].PlayerLevelPercentile, 'f', 2, 64),
+				Name:   loc.Sprintf("Level Percentile"),
+				Value:  strconv.FormatFloat(player.PlayerLevelPercentile, 'f', 2, 64),
 				Inline: true,
 			},
 			{
-				Name:   "Total XP",
-				Value:  strconv.Itoa(player[0].PlayerXP),
+				Name:   loc.Sprintf("Total XP"),
+				Value:  strconv.Itoa(player.PlayerXP),
 				Inline: true,
 			},
 			{
-				Name:   "XP To Next Level",
-				Value:  strconv.Itoa(player[0].PlayerXPNeededToLevelUp),
+				Name:   loc.Sprintf("XP To Next Level"),
+				Value:  strconv.Itoa(player.PlayerXPNeededToLevelUp),
 				Inline: true,
 			},
 		},
 	}
-	cmd.HandleMessageOk(embMsg, session, interaction, &logs)
+
+	embMsg.Fields = append(embMsg.Fields, &discordgo.MessageEmbedField{
+		Name:   loc.Sprintf("Status"),
+		Value:  personaStateText(loc, player),
+		Inline: true,
+	})
+
+	if player.InGame() {
+		game := cmd.HandleStringDefault(player.GameExtraInfo)
+		if appID, ok := player.GameAppID(); ok {
+			game = fmt.Sprintf("[%s](%sapp/%d)", game, steam.SteamPoweredAPI, appID)
+		}
+		if player.LobbySteamID != "" && player.HasFlag(steam.PersonaStateFlagInJoinableGame) {
+			game += "\n" + loc.Sprintf("Lobby open to join")
+		}
+
+		embMsg.Fields = append(embMsg.Fields, &discordgo.MessageEmbedField{
+			Name:   loc.Sprintf("Current Game"),
+			Value:  game,
+			Inline: true,
+		})
+	}
+
+	if server, ok := player.PublicGameServer(); ok {
+		embMsg.Fields = append(embMsg.Fields, &discordgo.MessageEmbedField{
+			Name:   loc.Sprintf("Server"),
+			Value:  fmt.Sprintf("`%s`", server),
+			Inline: true,
+		})
+	}
+
+	switch {
+	case !player.Configured():
+		embMsg.Footer = resolvedFooter(loc, resolved, loc.Sprintf("This player has not set up their community profile."))
+	case player.Private():
+		embMsg.Footer = resolvedFooter(loc, resolved, loc.Sprintf("Profile is private, real name, location and profile age are hidden."))
+	}
+
+	return interaction.Respond(embMsg)
+}
+
+// personaStateText describes what the player is doing and which Steam client
+// they are using
+func personaStateText(loc locale.Locale, player steam.Player) string {
+	if player.InGame() {
+		state := loc.Sprintf("In-Game")
+		switch {
+		case player.OnSteamDeck():
+			state = loc.Sprintf("Playing on Steam Deck")
+		case player.HasFlag(steam.PersonaStateFlagClientTypeVR):
+			state = loc.Sprintf("Playing in VR")
+		case player.HasFlag(steam.PersonaStateFlagRemotePlayTogether):
+			state = loc.Sprintf("Playing with Remote Play Together")
+		}
+		return "🎮 " + state
+	}
+
+	var state string
+	switch player.PersonaState {
+	case steam.PersonaStateOnline:
+		state = loc.Sprintf("Online")
+	case steam.PersonaStateBusy:
+		state = loc.Sprintf("Busy")
+	case steam.PersonaStateAway:
+		state = loc.Sprintf("Away")
+	case steam.PersonaStateSnooze:
+		state = loc.Sprintf("Snooze")
+	case steam.PersonaStateLookingToTrade:
+		state = loc.Sprintf("Looking to Trade")
+	case steam.PersonaStateLookingToPlay:
+		state = loc.Sprintf("Looking to Play")
+	default:
+		return fmt.Sprintf("%s %s", player.Status(), loc.Sprintf("Offline"))
+	}
+
+	switch {
+	case player.OnSteamDeck():
+		state = loc.Sprintf("%s on Steam Deck", state)
+	case player.HasFlag(steam.PersonaStateFlagClientTypeMobile):
+		state = loc.Sprintf("%s on mobile", state)
+	case player.HasFlag(steam.PersonaStateFlagClientTypeWeb):
+		state = loc.Sprintf("%s on the web", state)
+	case player.HasFlag(steam.PersonaStateFlagClientTypeVR):
+		state = loc.Sprintf("%s in VR", state)
+	}
+
+	return fmt.Sprintf("%s %s", player.Status(), state)
 }
@@ -0,0 +1,106 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/steam"
+)
+
+const (
+	// One embed per game besides the player's, Discord allows 10 per message
+	maxRecentGames = 9
+)
+
+func PlayerRecent(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string) error {
+	loc := interaction.Locale
+
+	resolved, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
+	if err != nil {
+		return err
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title:  loc.Sprintf("Recently Played"),
+		Color:  0x66c0f4,
+		Footer: resolvedFooter(loc, resolved),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: player.AvatarFull,
+		},
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
+		},
+	}
+
+	recentApps, err := steamClient.AppsRecentlyPlayed(ctx, player.SteamID)
+	if errors.Is(err, steam.ErrPrivateProfile) {
+		embMsg.Description = loc.Sprintf("Game details are private.")
+		return interaction.Respond(embMsg)
+	}
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve recently played games")
+	}
+
+	// The game name is sometimes missing from the summary, recently played
+	// games have it when the game is on Steam
+	currentGame := player.GameExtraInfo
+	recentMinutes := 0
+	for _, v := range *recentApps {
+		recentMinutes += v.PlayTime2Weeks
+		if currentGame == "" && strconv.Itoa(v.AppID) == player.GameID {
+			currentGame = v.Name
+		}
+	}
+
+	if player.InGame() {
+		embMsg.Description = loc.Sprintf("🎮 Currently playing **%s**", cmd.HandleStringDefault(cmd.EscapeMarkdown(currentGame))) + "\n"
+	}
+	if len(*recentApps) == 0 {
+		embMsg.Description += loc.Sprintf("No games played in the last 2 weeks.")
+		return interaction.Respond(embMsg)
+	}
+	embMsg.Description += loc.Sprintf("%d games played for %s in the last 2 weeks.", len(*recentApps), formatPlaytime(recentMinutes))
+
+	if len(*recentApps) > maxRecentGames {
+		embMsg.Footer = resolvedFooter(loc, resolved, loc.Sprintf("Showing %d of %d games", maxRecentGames, len(*recentApps)))
+	}
+
+	embeds := []*discordgo.MessageEmbed{embMsg}
+	for _, v := range (*recentApps)[:min(maxRecentGames, len(*recentApps))] {
+		appID := strconv.Itoa(v.AppID)
+
+		gameEmb := &discordgo.MessageEmbed{
+			Title: v.Name,
+			URL:   steam.SteamPoweredAPI + "app/" + appID,
+			Color: 0x66c0f4,
+			Thumbnail: &discordgo.MessageEmbedThumbnail{
+				URL: steam.AppHeaderImage(v.AppID),
+			},
+			Fields: []*discordgo.MessageEmbedField{
+				{
+					Name:   loc.Sprintf("Last 2 Weeks"),
+					Value:  formatPlaytime(v.PlayTime2Weeks),
+					Inline: true,
+				},
+				{
+					Name:   loc.Sprintf("Total"),
+					Value:  formatPlaytime(v.PlayTimeForever),
+					Inline: true,
+				},
+			},
+		}
+
+		if player.GameID == appID {
+			gameEmb.Description = loc.Sprintf("🟢 Currently in-game")
+		}
+
+		embeds = append(embeds, gameEmb)
+	}
+
+	return interaction.RespondEmbeds(embeds)
+}
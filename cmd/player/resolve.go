@@ -0,0 +1,18 @@
+package player
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/locale"
+	"github.com/the-steam-hub/discord-bot/steam"
+)
+
+// resolvedFooter tells the user how their input was understood, followed by
+// any notes about the data shown
+func resolvedFooter(loc locale.Locale, resolved steam.ResolvedID, notes ...string) *discordgo.MessageEmbedFooter {
+	text := append(notes, loc.Sprintf("Detected input: %s", loc.Sprintf(resolved.Source.String())))
+	return &discordgo.MessageEmbedFooter{
+		Text: strings.Join(text, " • "),
+	}
+}
@@ -0,0 +1,154 @@
+package player
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/steam"
+)
+
+const (
+	mostExpensiveCount = 5
+)
+
+func PlayerWorth(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, input string, region string) error {
+	loc := interaction.Locale
+	storeLocale := loc.StoreLocale(region)
+
+	resolved, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
+	if err != nil {
+		return err
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title:  loc.Sprintf("Library Value"),
+		Color:  0x66c0f4,
+		Footer: resolvedFooter(loc, resolved, loc.Sprintf("Current store prices in %s", storeLocale.CountryCode)),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: player.AvatarFull,
+		},
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
+		},
+	}
+
+	ownedApps, err := steamClient.AppsOwned(ctx, player.SteamID)
+	if errors.Is(err, steam.ErrPrivateProfile) {
+		embMsg.Description = loc.Sprintf("Game details are private.")
+		return interaction.Respond(embMsg)
+	}
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve owned games")
+	}
+
+	appIDs := make([]int, len(*ownedApps))
+	for k, v := range *ownedApps {
+		appIDs[k] = v.AppID
+	}
+
+	priceList, err := steamClient.AppPriceList(ctx, storeLocale.CountryCode, appIDs...)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve game prices")
+	}
+
+	notCounted := ""
+	if len(priceList.Free) > 0 {
+		notCounted += loc.Sprintf("%d free games", len(priceList.Free)) + "\n"
+	}
+	if len(priceList.Unlisted) > 0 {
+		notCounted += loc.Sprintf("%d games delisted or not sold in %s", len(priceList.Unlisted), storeLocale.CountryCode) + "\n"
+	}
+
+	if len(priceList.Prices) == 0 {
+		embMsg.Description = loc.Sprintf("None of the games in this library are sold in %s.", storeLocale.CountryCode)
+		return interaction.Respond(embMsg)
+	}
+
+	var (
+		currency      string
+		total         int
+		unplayed      int
+		unplayedCount int
+		minutes       int
+		priced        []steam.AppPlayTime
+	)
+	for _, v := range *ownedApps {
+		price, ok := priceList.Prices[v.AppID]
+		if !ok {
+			continue
+		}
+
+		currency = price.Currency
+		total += price.Final
+		minutes += v.PlayTimeForever
+		if v.PlayTimeForever == 0 {
+			unplayed += price.Final
+			unplayedCount++
+		}
+		priced = append(priced, v)
+	}
+
+	costPerHour := "-"
+	if minutes > 0 {
+		costPerHour = formatMoney(float64(total)/(float64(minutes)/60), currency)
+	}
+
+	slices.SortStableFunc(priced, func(a, b steam.AppPlayTime) int {
+		return cmp.Compare(priceList.Prices[b.AppID].Final, priceList.Prices[a.AppID].Final)
+	})
+
+	mostExpensive := ""
+	for _, v := range priced[:min(mostExpensiveCount, len(priced))] {
+		mostExpensive += fmt.Sprintf("**%s** · %s\n", cmd.EscapeMarkdown(v.Name), priceList.Prices[v.AppID].FinalFormatted)
+	}
+
+	embMsg.Fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   loc.Sprintf("Total Value"),
+			Value:  formatMoney(float64(total), currency),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Pile of Shame"),
+			Value:  loc.Sprintf("%s (%d unplayed games)", formatMoney(float64(unplayed), currency), unplayedCount),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Cost per Hour"),
+			Value:  costPerHour,
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Priced Games"),
+			Value:  loc.Sprintf("%d of %d", len(priced), len(*ownedApps)),
+			Inline: true,
+		},
+		{
+			Name:   loc.Sprintf("Not Counted"),
+			Value:  cmd.HandleStringDefault(notCounted),
+			Inline: true,
+		},
+		{
+			Name:   "",
+			Value:  "",
+			Inline: true,
+		},
+		{
+			Name:  loc.Sprintf("Most Expensive Games"),
+			Value: mostExpensive,
+		},
+	}
+
+	return interaction.Respond(embMsg)
+}
+
+// formatMoney formats an amount in the currency's minor unit, e.g. cents
+func formatMoney(amount float64, currency string) string {
+	return fmt.Sprintf("%.2f %s", amount/100, currency)
+}
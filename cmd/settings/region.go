@@ -0,0 +1,31 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/steam"
+	"github.com/the-steam-hub/discord-bot/store"
+)
+
+func Region(ctx context.Context, interaction *cmd.Interaction, dataStore *store.Store, input string) error {
+	loc := interaction.Locale
+
+	countryCode, err := steam.ParseCountryCode(input)
+	if err != nil {
+		return cmd.NewUserError(err, "invalid region, expected a two letter country code such as US or GB")
+	}
+
+	err = dataStore.SetGuildRegion(interaction.GuildID, countryCode)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to save region")
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title:       loc.Sprintf("Default Region Updated"),
+		Description: loc.Sprintf("Store prices are now shown for %s %s", steam.CountryFlag(countryCode), countryCode),
+		Color:       0x66c0f4,
+	}
+	return interaction.Respond(embMsg)
+}
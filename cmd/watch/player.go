@@ -0,0 +1,132 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/locale"
+	"github.com/the-steam-hub/discord-bot/steam"
+	"github.com/the-steam-hub/discord-bot/store"
+)
+
+// NoQuietHour is passed for quiet hours the user did not provide
+const NoQuietHour = -1
+
+func PlayerAdd(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, dataStore *store.Store, maxPerGuild int, input string, gameInput string, destination string, quietStart int, quietEnd int, region string) error {
+	loc := interaction.Locale
+	storeLocale := loc.StoreLocale(region)
+
+	if len(dataStore.UserPlayerWatches(interaction.Member.User.ID)) >= maxWatchesPerUser {
+		return cmd.NewUserError(nil, "you can watch at most %d players, remove one with /watch remove first", maxWatchesPerUser)
+	}
+
+	if maxPerGuild > 0 && len(dataStore.GuildPlayerWatches(interaction.GuildID)) >= maxPerGuild {
+		return cmd.NewUserError(nil, "this server already watches %d players, which is the limit", maxPerGuild)
+	}
+
+	if (quietStart == NoQuietHour) != (quietEnd == NoQuietHour) {
+		return cmd.NewUserError(nil, "quiet hours need both a start and an end hour")
+	}
+
+	_, player, err := cmd.ResolvePlayer(ctx, steamClient, input)
+	if err != nil {
+		return err
+	}
+
+	w := store.PlayerWatch{
+		SteamID:          player.SteamID,
+		PlayerName:       player.Name,
+		UserID:           interaction.Member.User.ID,
+		GuildID:          interaction.GuildID,
+		ChannelID:        interaction.ChannelID,
+		DM:               destination != "channel",
+		Locale:           string(loc.Discord()),
+		LastPersonaState: player.PersonaState,
+		LastGameID:       player.GameID,
+		LastGameName:     player.GameExtraInfo,
+	}
+
+	if quietStart != NoQuietHour {
+		w.QuietHours = &store.QuietHours{
+			Start: quietStart,
+			End:   quietEnd,
+		}
+	}
+
+	if gameInput != "" {
+		appID, err := steamClient.AppSearch(ctx, gameInput, storeLocale)
+		if err != nil {
+			return cmd.GameNotFound(err)
+		}
+
+		appData, err := steamClient.AppDetailedData(ctx, appID, storeLocale)
+		if err != nil {
+			return cmd.NewUserError(err, "unable to retrieve game data")
+		}
+
+		w.AppID = appID
+		w.AppName = appData.Name
+	}
+
+	w, err = dataStore.AddPlayerWatch(w)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to save watch")
+	}
+
+	description := loc.Sprintf("You will be notified when this player comes online, goes offline or starts playing a game.")
+	if w.AppID != 0 {
+		description = loc.Sprintf("You will be notified when this player starts or stops playing %s.", w.AppName)
+	}
+	if player.Private() {
+		description += "\n" + loc.Sprintf("This profile is private, games will only be announced once it is public.")
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: fmt.Sprintf("%s %s", player.Status(), player.Name),
+			URL:  player.ProfileURL,
+		},
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: player.AvatarFull,
+		},
+		Description: description,
+		Color:       0x66c0f4,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.Sprintf("Game"),
+				Value:  formatWatchedGame(loc, w),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Quiet Hours"),
+				Value:  formatQuietHours(loc, w.QuietHours),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Notify"),
+				Value:  formatDestination(loc, w.DM, w.ChannelID),
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: loc.Sprintf("Watch ID: %s", w.ID),
+		},
+	}
+	return interaction.Respond(embMsg)
+}
+
+func formatWatchedGame(loc locale.Locale, w store.PlayerWatch) string {
+	if w.AppID == 0 {
+		return loc.Sprintf("Any game")
+	}
+	return w.AppName
+}
+
+func formatQuietHours(loc locale.Locale, q *store.QuietHours) string {
+	if q == nil || q.Start == q.End {
+		return loc.Sprintf("None")
+	}
+	return fmt.Sprintf("%02d:00 - %02d:00 UTC", q.Start, q.End)
+}
@@ -0,0 +1,231 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/locale"
+	"github.com/the-steam-hub/discord-bot/steam"
+	"github.com/the-steam-hub/discord-bot/store"
+)
+
+const (
+	maxWatchesPerUser = 25
+)
+
+var (
+	errInvalidTargetPrice = errors.New("invalid target price")
+)
+
+func PriceAdd(ctx context.Context, interaction *cmd.Interaction, steamClient steam.Steam, dataStore *store.Store, input string, targetInput string, destination string, region string) error {
+	loc := interaction.Locale
+	storeLocale := loc.StoreLocale(region)
+
+	if len(dataStore.UserPriceWatches(interaction.Member.User.ID)) >= maxWatchesPerUser {
+		return cmd.NewUserError(nil, "you can watch at most %d games, remove one with /watch remove first", maxWatchesPerUser)
+	}
+
+	target, err := parseTargetPrice(targetInput)
+	if err != nil {
+		return cmd.NewUserError(err, "invalid target price, expected a number such as 19.99")
+	}
+
+	appID, err := steamClient.AppSearch(ctx, input, storeLocale)
+	if err != nil {
+		return cmd.GameNotFound(err)
+	}
+
+	appData, err := steamClient.AppDetailedData(ctx, appID, storeLocale)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve game data")
+	}
+
+	prices, err := steamClient.AppPrices(ctx, storeLocale.CountryCode, appID)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to retrieve game price")
+	}
+
+	price, ok := prices[appID]
+	if !ok {
+		return cmd.NewUserError(nil, "%s is free or not sold in %s", appData.Name, storeLocale.CountryCode)
+	}
+
+	w := store.PriceWatch{
+		AppID:        appID,
+		AppName:      appData.Name,
+		Region:       storeLocale.CountryCode,
+		Currency:     price.Currency,
+		Locale:       string(loc.Discord()),
+		UserID:       interaction.Member.User.ID,
+		GuildID:      interaction.GuildID,
+		ChannelID:    interaction.ChannelID,
+		DM:           destination != "channel",
+		TargetPrice:  target,
+		LastFinal:    price.Final,
+		LastDiscount: price.DiscountPercent,
+	}
+
+	// The user can already see the current price, so it should not be
+	// announced again on the next poll
+	if price.DiscountPercent > 0 || (target > 0 && price.Final <= target) {
+		w.AnnouncedFinal = price.Final
+		w.AnnouncedDiscount = price.DiscountPercent
+	}
+
+	w, err = dataStore.AddPriceWatch(w)
+	if err != nil {
+		return cmd.NewUserError(err, "unable to save watch")
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title: appData.Name,
+		URL:   steam.SteamPoweredAPI + "app/" + strconv.Itoa(appID),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: appData.HeaderImage,
+		},
+		Description: loc.Sprintf("You will be notified when this game goes on sale."),
+		Color:       0x66c0f4,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.Sprintf("Current Price"),
+				Value:  price.FinalFormatted,
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Target Price"),
+				Value:  formatTargetPrice(loc, w),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Notify"),
+				Value:  formatDestination(loc, w.DM, w.ChannelID),
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: loc.Sprintf("Watch ID: %s", w.ID),
+		},
+	}
+	return interaction.Respond(embMsg)
+}
+
+// List shows the games and players the user is watching
+func List(ctx context.Context, interaction *cmd.Interaction, dataStore *store.Store) error {
+	loc := interaction.Locale
+
+	IDs, games, targets := "", "", ""
+	for _, w := range dataStore.UserPriceWatches(interaction.Member.User.ID) {
+		IDs += fmt.Sprintf("`%s`\n", w.ID)
+		games += fmt.Sprintf("%s\n", w.AppName)
+		targets += fmt.Sprintf("%s\n", formatTargetPrice(loc, w))
+	}
+
+	playerIDs, players, playerGames := "", "", ""
+	for _, w := range dataStore.UserPlayerWatches(interaction.Member.User.ID) {
+		playerIDs += fmt.Sprintf("`%s`\n", w.ID)
+		players += fmt.Sprintf("%s\n", w.PlayerName)
+		playerGames += fmt.Sprintf("%s\n", formatWatchedGame(loc, w))
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title: loc.Sprintf("Watched Games"),
+		Color: 0x66c0f4,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.Sprintf("ID"),
+				Value:  cmd.HandleStringDefault(IDs),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Game"),
+				Value:  cmd.HandleStringDefault(games),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Target Price"),
+				Value:  cmd.HandleStringDefault(targets),
+				Inline: true,
+			},
+		},
+	}
+
+	playerEmb := &discordgo.MessageEmbed{
+		Title: loc.Sprintf("Watched Players"),
+		Color: 0x66c0f4,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.Sprintf("ID"),
+				Value:  cmd.HandleStringDefault(playerIDs),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Player"),
+				Value:  cmd.HandleStringDefault(players),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Game"),
+				Value:  cmd.HandleStringDefault(playerGames),
+				Inline: true,
+			},
+		},
+	}
+	return interaction.RespondEmbeds([]*discordgo.MessageEmbed{embMsg, playerEmb})
+}
+
+// Remove deletes a price or player watch of the user
+func Remove(ctx context.Context, interaction *cmd.Interaction, dataStore *store.Store, input string) error {
+	loc := interaction.Locale
+
+	err := dataStore.RemovePriceWatch(input, interaction.Member.User.ID)
+	if errors.Is(err, store.ErrWatchNotFound) {
+		err = dataStore.RemovePlayerWatch(input, interaction.Member.User.ID)
+	}
+	if err != nil {
+		return cmd.NewUserError(err, "unable to remove watch, check the ID with /watch list")
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Title:       loc.Sprintf("Watch Removed"),
+		Description: loc.Sprintf("Watch `%s` has been removed.", input),
+		Color:       0x66c0f4,
+	}
+	return interaction.Respond(embMsg)
+}
+
+// parseTargetPrice converts a decimal price into the currencies minor unit
+//
+// Example: "19.99" -> 1999
+func parseTargetPrice(input string) (int, error) {
+	input = strings.TrimSpace(strings.ReplaceAll(input, ",", "."))
+	if input == "" {
+		return 0, nil
+	}
+
+	price, err := strconv.ParseFloat(input, 64)
+	if err != nil || price <= 0 {
+		return 0, errInvalidTargetPrice
+	}
+
+	return int(math.Round(price * 100)), nil
+}
+
+func formatTargetPrice(loc locale.Locale, w store.PriceWatch) string {
+	if w.TargetPrice == 0 {
+		return loc.Sprintf("Any discount")
+	}
+	return fmt.Sprintf("%.2f %s", float64(w.TargetPrice)/100, w.Currency)
+}
+
+func formatDestination(loc locale.Locale, dm bool, channelID string) string {
+	if dm {
+		return loc.Sprintf("Direct message")
+	}
+	return "<#" + channelID + ">"
+}
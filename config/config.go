@@ -0,0 +1,306 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds every setting of the bot. Values are resolved in the
+// following order, later sources override earlier ones:
+//
+//  1. Defaults
+//  2. The YAML file named by CONFIG_FILE, or config.yaml if it exists
+//  3. The .env.<BOT_ENV> file, if it exists
+//  4. Environment variables
+type Config struct {
+	Env          string `yaml:"env"`
+	DiscordToken string `yaml:"discord_token"`
+	SteamAPIKey  string `yaml:"steam_api_key"`
+	DataFile     string `yaml:"data_file"`
+	MetricsAddr  string `yaml:"metrics_addr"`
+
+	Log struct {
+		Format string `yaml:"format"`
+		Level  string `yaml:"level"`
+	} `yaml:"log"`
+
+	Timeouts struct {
+		Steam    time.Duration `yaml:"steam"`
+		Shutdown time.Duration `yaml:"shutdown"`
+	} `yaml:"timeouts"`
+
+	Cache struct {
+		Prices        time.Duration `yaml:"prices"`
+		ExchangeRates time.Duration `yaml:"exchange_rates"`
+	} `yaml:"cache"`
+
+	Features struct {
+		PriceWatch  bool `yaml:"price_watch"`
+		PlayerWatch bool `yaml:"player_watch"`
+		BanWatch    bool `yaml:"ban_watch"`
+		// Skips the Steam API key check at startup, useful when Steam is
+		// unreachable from the build environment
+		SkipKeyCheck bool `yaml:"skip_key_check"`
+	} `yaml:"features"`
+
+	PriceWatch struct {
+		Interval time.Duration `yaml:"interval"`
+	} `yaml:"price_watch"`
+
+	PlayerWatch struct {
+		Interval time.Duration `yaml:"interval"`
+		// MaxPerGuild limits the players watched in each guild, as every
+		// watch is polled and may post alerts. Zero means no limit.
+		MaxPerGuild int `yaml:"max_per_guild"`
+	} `yaml:"player_watch"`
+
+	BanWatch struct {
+		Interval time.Duration `yaml:"interval"`
+		// MaxPerGuild limits the players each guild may watch the bans
+		// of. Zero means no limit.
+		MaxPerGuild int `yaml:"max_per_guild"`
+	} `yaml:"ban_watch"`
+
+	RateLimit struct {
+		UserCooldown  time.Duration `yaml:"user_cooldown"`
+		GuildCooldown time.Duration `yaml:"guild_cooldown"`
+		// Commands maps a command path such as "player friends" to a
+		// cooldown that applies to each user
+		Commands      map[string]time.Duration `yaml:"commands"`
+		MaxConcurrent int                      `yaml:"max_concurrent"`
+		HeavyCommands []string                 `yaml:"heavy_commands"`
+	} `yaml:"rate_limit"`
+}
+
+const (
+	defaultConfigFile = "config.yaml"
+)
+
+var (
+	ErrMissingValue = errors.New("missing required value")
+	ErrInvalidValue = errors.New("invalid value")
+)
+
+func Default() Config {
+	var c Config
+	c.Env = "development"
+	c.DataFile = "data.json"
+	c.Log.Format = "text"
+	c.Log.Level = "info"
+	c.Timeouts.Steam = 10 * time.Second
+	c.Timeouts.Shutdown = 30 * time.Second
+	c.Cache.Prices = 10 * time.Minute
+	c.Cache.ExchangeRates = 6 * time.Hour
+	c.Features.PriceWatch = true
+	c.Features.PlayerWatch = true
+	c.Features.BanWatch = true
+	c.PriceWatch.Interval = 30 * time.Minute
+	c.PlayerWatch.Interval = 2 * time.Minute
+	c.PlayerWatch.MaxPerGuild = 25
+	c.BanWatch.Interval = 6 * time.Hour
+	c.BanWatch.MaxPerGuild = 500
+	c.RateLimit.UserCooldown = 3 * time.Second
+	c.RateLimit.Commands = map[string]time.Duration{
+		"player friends": 30 * time.Second,
+		"player games":   10 * time.Second,
+		"player worth":   30 * time.Second,
+	}
+	c.RateLimit.MaxConcurrent = 4
+	c.RateLimit.HeavyCommands = []string{"player friends", "player games", "player bans", "player mutuals", "player library", "player worth", "game price"}
+	return c
+}
+
+// Load resolves the configuration from all sources and validates it
+func Load() (Config, error) {
+	c := Default()
+
+	path, required := os.Getenv("CONFIG_FILE"), true
+	if path == "" {
+		path, required = defaultConfigFile, false
+	}
+
+	err := c.loadFile(path, required)
+	if err != nil {
+		return c, err
+	}
+
+	env := os.Getenv("BOT_ENV")
+	if env == "" {
+		env = c.Env
+	}
+
+	// Variables already set in the environment take precedence over the
+	// .env file, godotenv never overrides them
+	err = godotenv.Load(".env." + env)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return c, fmt.Errorf("error loading .env.%s: %w", env, err)
+	}
+
+	err = c.loadEnv()
+	if err != nil {
+		return c, err
+	}
+
+	return c, c.Validate()
+}
+
+func (c *Config) loadFile(path string, required bool) error {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !required {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading config file: %w", err)
+	}
+
+	err = yaml.Unmarshal(b, c)
+	if err != nil {
+		return fmt.Errorf("error parsing config file %s: %w", path, err)
+	}
+	return nil
+}
+
+func (c *Config) loadEnv() error {
+	values := map[string]*string{
+		"BOT_ENV":           &c.Env,
+		"DISCORD_BOT_TOKEN": &c.DiscordToken,
+		"STEAM_API_KEY":     &c.SteamAPIKey,
+		"BOT_DATA_FILE":     &c.DataFile,
+		"METRICS_ADDR":      &c.MetricsAddr,
+		"LOG_FORMAT":        &c.Log.Format,
+		"LOG_LEVEL":         &c.Log.Level,
+	}
+
+	durations := map[string]*time.Duration{
+		"STEAM_TIMEOUT":             &c.Timeouts.Steam,
+		"SHUTDOWN_TIMEOUT":          &c.Timeouts.Shutdown,
+		"PRICE_CACHE_TTL":           &c.Cache.Prices,
+		"EXCHANGE_CACHE_TTL":        &c.Cache.ExchangeRates,
+		"PRICE_WATCH_INTERVAL":      &c.PriceWatch.Interval,
+		"PLAYER_WATCH_INTERVAL":     &c.PlayerWatch.Interval,
+		"BAN_WATCH_INTERVAL":        &c.BanWatch.Interval,
+		"RATE_LIMIT_USER_COOLDOWN":  &c.RateLimit.UserCooldown,
+		"RATE_LIMIT_GUILD_COOLDOWN": &c.RateLimit.GuildCooldown,
+	}
+
+	ints := map[string]*int{
+		"RATE_LIMIT_MAX_CONCURRENT":  &c.RateLimit.MaxConcurrent,
+		"PLAYER_WATCH_MAX_PER_GUILD": &c.PlayerWatch.MaxPerGuild,
+		"BAN_WATCH_MAX_PER_GUILD":    &c.BanWatch.MaxPerGuild,
+	}
+
+	bools := map[string]*bool{
+		"FEATURE_PRICE_WATCH":  &c.Features.PriceWatch,
+		"FEATURE_PLAYER_WATCH": &c.Features.PlayerWatch,
+		"FEATURE_BAN_WATCH":    &c.Features.BanWatch,
+		"SKIP_KEY_CHECK":       &c.Features.SkipKeyCheck,
+	}
+
+	for name, v := range values {
+		if env, ok := os.LookupEnv(name); ok {
+			*v = env
+		}
+	}
+
+	var errs []error
+	for name, v := range durations {
+		if env, ok := os.LookupEnv(name); ok {
+			d, err := time.ParseDuration(env)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%w %s: %s", ErrInvalidValue, name, err))
+				continue
+			}
+			*v = d
+		}
+	}
+
+	for name, v := range ints {
+		if env, ok := os.LookupEnv(name); ok {
+			i, err := strconv.Atoi(env)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%w %s: %s", ErrInvalidValue, name, err))
+				continue
+			}
+			*v = i
+		}
+	}
+
+	for name, v := range bools {
+		if env, ok := os.LookupEnv(name); ok {
+			b, err := strconv.ParseBool(env)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%w %s: %s", ErrInvalidValue, name, err))
+				continue
+			}
+			*v = b
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate reports every missing or invalid value at once, so a broken
+// deployment can be fixed in a single iteration
+func (c Config) Validate() error {
+	var errs []error
+
+	if c.DiscordToken == "" {
+		errs = append(errs, fmt.Errorf("%w: DISCORD_BOT_TOKEN", ErrMissingValue))
+	}
+
+	if c.SteamAPIKey == "" {
+		errs = append(errs, fmt.Errorf("%w: STEAM_API_KEY", ErrMissingValue))
+	}
+
+	if c.DataFile == "" {
+		errs = append(errs, fmt.Errorf("%w: BOT_DATA_FILE", ErrMissingValue))
+	}
+
+	positive := map[string]time.Duration{
+		"STEAM_TIMEOUT":         c.Timeouts.Steam,
+		"SHUTDOWN_TIMEOUT":      c.Timeouts.Shutdown,
+		"PRICE_CACHE_TTL":       c.Cache.Prices,
+		"EXCHANGE_CACHE_TTL":    c.Cache.ExchangeRates,
+		"PRICE_WATCH_INTERVAL":  c.PriceWatch.Interval,
+		"PLAYER_WATCH_INTERVAL": c.PlayerWatch.Interval,
+		"BAN_WATCH_INTERVAL":    c.BanWatch.Interval,
+	}
+	for name, v := range positive {
+		if v <= 0 {
+			errs = append(errs, fmt.Errorf("%w %s: must be greater than zero", ErrInvalidValue, name))
+		}
+	}
+
+	notNegative := map[string]time.Duration{
+		"RATE_LIMIT_USER_COOLDOWN":  c.RateLimit.UserCooldown,
+		"RATE_LIMIT_GUILD_COOLDOWN": c.RateLimit.GuildCooldown,
+	}
+	for name, v := range c.RateLimit.Commands {
+		notNegative["rate_limit.commands."+name] = v
+	}
+	for name, v := range notNegative {
+		if v < 0 {
+			errs = append(errs, fmt.Errorf("%w %s: must not be negative", ErrInvalidValue, name))
+		}
+	}
+
+	if c.RateLimit.MaxConcurrent < 0 {
+		errs = append(errs, fmt.Errorf("%w RATE_LIMIT_MAX_CONCURRENT: must not be negative", ErrInvalidValue))
+	}
+
+	if c.PlayerWatch.MaxPerGuild < 0 {
+		errs = append(errs, fmt.Errorf("%w PLAYER_WATCH_MAX_PER_GUILD: must not be negative", ErrInvalidValue))
+	}
+
+	if c.BanWatch.MaxPerGuild < 0 {
+		errs = append(errs, fmt.Errorf("%w BAN_WATCH_MAX_PER_GUILD: must not be negative", ErrInvalidValue))
+	}
+
+	return errors.Join(errs...)
+}
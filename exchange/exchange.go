@@ -0,0 +1,127 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/the-steam-hub/discord-bot/cache"
+)
+
+const (
+	ExchangeRateAPI = "https://open.er-api.com/v6/latest/"
+	baseCurrency    = "USD"
+)
+
+var (
+	ErrUnknownCurrency = errors.New("unknown currency")
+)
+
+// Rates are refreshed at most every few hours, the upstream API only
+// publishes new rates once a day
+var rates = cache.New[string, map[string]float64]("exchange_rates", 6*time.Hour)
+
+var client = &http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// SetCacheTTL changes how long rates are cached. It must be called before
+// any conversion is made.
+func SetCacheTTL(ttl time.Duration) {
+	rates = cache.New[string, map[string]float64]("exchange_rates", ttl)
+}
+
+// SetTimeout bounds how long fetching the rates may take. It must be called
+// before any conversion is made.
+func SetTimeout(timeout time.Duration) {
+	client = &http.Client{
+		Timeout: timeout,
+	}
+}
+
+// Rates holds the value of one US dollar in each ISO 4217 currency
+type Rates map[string]float64
+
+// Convert converts an amount from one ISO 4217 currency to another. A nil
+// Rates can only convert a currency into itself.
+func (r Rates) Convert(amount float64, from string, to string) (float64, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return amount, nil
+	}
+
+	fromRate, ok := r[from]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, from)
+	}
+
+	toRate, ok := r[to]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, to)
+	}
+
+	return amount / fromRate * toRate, nil
+}
+
+// Convert converts an amount from one ISO 4217 currency to another. Callers
+// converting several amounts should fetch the rates once with Latest.
+func Convert(ctx context.Context, amount float64, from string, to string) (float64, error) {
+	if strings.EqualFold(from, to) {
+		return amount, nil
+	}
+
+	r, err := Latest(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.Convert(amount, from, to)
+}
+
+// Latest returns the current exchange rates
+func Latest(ctx context.Context) (Rates, error) {
+	if r, ok := rates.Get(baseCurrency); ok {
+		return r, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ExchangeRateAPI+baseCurrency, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Result string             `json:"result"`
+		Rates  map[string]float64 `json:"rates"`
+	}
+
+	err = json.Unmarshal(b, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Result != "success" || len(response.Rates) == 0 {
+		return nil, errors.New("exchange rates unavailable")
+	}
+
+	rates.Set(baseCurrency, response.Rates)
+	return response.Rates, nil
+}
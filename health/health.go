@@ -0,0 +1,83 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+// Status tracks the state Kubernetes probes report on. The Discord session
+// is flagged open and closed by the gateway event handlers, commands are
+// flagged once they have been registered.
+type Status struct {
+	sessionOpen        atomic.Bool
+	commandsRegistered atomic.Bool
+}
+
+func (s *Status) SetSessionOpen(open bool) {
+	s.sessionOpen.Store(open)
+}
+
+func (s *Status) SetCommandsRegistered(registered bool) {
+	s.commandsRegistered.Store(registered)
+}
+
+// Healthy reports whether the connection to Discord is open
+func (s *Status) Healthy() bool {
+	return s.sessionOpen.Load()
+}
+
+// Ready reports whether the bot can answer commands
+func (s *Status) Ready() bool {
+	return s.sessionOpen.Load() && s.commandsRegistered.Load()
+}
+
+// Server exposes /metrics, /healthz and /readyz
+type Server struct {
+	server *http.Server
+}
+
+func NewServer(addr string, status *Status) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/healthz", probe(status.Healthy))
+	mux.HandleFunc("/readyz", probe(status.Ready))
+
+	return &Server{
+		server: &http.Server{
+			Addr:              addr,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+	}
+}
+
+// Start serves requests in the background until Shutdown is called
+func (s *Server) Start() {
+	go func() {
+		logrus.Infof("serving metrics and health checks on %s", s.server.Addr)
+		err := s.server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logrus.WithError(err).Error("metrics server stopped")
+		}
+	}()
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+func probe(check func() bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !check() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}
+}
@@ -0,0 +1,144 @@
+package lifecycle
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Manager coordinates the shutdown of the bot. Interaction handlers and
+// background workers register with it so a shutdown can wait for them to
+// finish before the resources they depend on are closed.
+type Manager struct {
+	mu       sync.Mutex
+	draining bool
+	inflight sync.WaitGroup
+	workers  sync.WaitGroup
+	ctx      context.Context
+	cancel   context.CancelFunc
+	// Interactions are cancelled only once draining gives up on them
+	interactionCtx    context.Context
+	cancelInteraction context.CancelFunc
+	hooks             []hook
+}
+
+// Container runtimes send SIGTERM, CTRL+C sends SIGINT
+var shutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+type hook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+func New() *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	interactionCtx, cancelInteraction := context.WithCancel(context.Background())
+	return &Manager{
+		ctx:               ctx,
+		cancel:            cancel,
+		interactionCtx:    interactionCtx,
+		cancelInteraction: cancelInteraction,
+	}
+}
+
+// Context is cancelled as soon as a shutdown starts, background workers
+// should return once it is done
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// InteractionContext is the base context of interaction handlers. Unlike
+// Context it stays alive while a shutdown drains in-flight interactions and
+// is only cancelled once the drain finished or timed out.
+func (m *Manager) InteractionContext() context.Context {
+	return m.interactionCtx
+}
+
+// Acquire registers an in-flight interaction. It returns false once the
+// shutdown has started, in which case the interaction must be rejected.
+// Every successful Acquire must be followed by a Release.
+func (m *Manager) Acquire() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.draining {
+		return false
+	}
+	m.inflight.Add(1)
+	return true
+}
+
+func (m *Manager) Release() {
+	m.inflight.Done()
+}
+
+// Go runs a background worker until the manager's context is cancelled
+func (m *Manager) Go(name string, fn func(ctx context.Context)) {
+	m.workers.Add(1)
+	go func() {
+		defer m.workers.Done()
+		fn(m.ctx)
+		logrus.Infof("%s stopped", name)
+	}()
+}
+
+// OnShutdown registers a function that runs once in-flight work has
+// drained. Hooks run in the order they were registered.
+func (m *Manager) OnShutdown(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, hook{name: name, fn: fn})
+}
+
+// WaitForSignal blocks until the process receives SIGINT or SIGTERM
+func (m *Manager) WaitForSignal() {
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, shutdownSignals...)
+	sig := <-stop
+	signal.Stop(stop)
+	logrus.Infof("received %s, shutting down...", sig)
+}
+
+// Shutdown stops accepting interactions, cancels background workers and
+// waits for both to finish before running the shutdown hooks. Waiting is
+// bounded by timeout, hooks are run regardless so state is still flushed.
+func (m *Manager) Shutdown(timeout time.Duration) {
+	m.mu.Lock()
+	m.draining = true
+	hooks := m.hooks
+	m.mu.Unlock()
+
+	m.cancel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	drained := make(chan struct{})
+	go func() {
+		m.inflight.Wait()
+		m.workers.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		logrus.Info("in-flight work drained")
+	case <-ctx.Done():
+		logrus.Warnf("in-flight work did not drain within %s", timeout)
+	}
+	m.cancelInteraction()
+
+	for _, h := range hooks {
+		err := h.fn(ctx)
+		if err != nil {
+			logrus.WithError(err).Errorf("error during shutdown of %s", h.name)
+			continue
+		}
+		logrus.Infof("%s shut down", h.name)
+	}
+}
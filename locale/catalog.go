@@ -0,0 +1,27 @@
+package locale
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var messages = newCatalog()
+
+// Translations are keyed by the English message, see de.go, fr.go and es.go
+func newCatalog() *catalog.Builder {
+	b := catalog.NewBuilder(catalog.Fallback(language.English))
+
+	translations := map[language.Tag]map[string]string{
+		language.German:  german,
+		language.French:  french,
+		language.Spanish: spanish,
+	}
+
+	for tag, m := range translations {
+		for key, msg := range m {
+			b.SetString(tag, key, msg)
+		}
+	}
+
+	return b
+}
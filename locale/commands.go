@@ -0,0 +1,40 @@
+package locale
+
+import (
+	"github.com/bwmarrin/discordgo"
+)
+
+// LocalizeCommands adds the translated names and descriptions of all
+// commands and their options, so Discord shows them in the user's language
+func LocalizeCommands(commands []*discordgo.ApplicationCommand) {
+	for _, c := range commands {
+		names := localizations(c.Name)
+		descriptions := localizations(c.Description)
+		c.NameLocalizations = &names
+		c.DescriptionLocalizations = &descriptions
+		localizeOptions(c.Options)
+	}
+}
+
+func localizeOptions(options []*discordgo.ApplicationCommandOption) {
+	for _, o := range options {
+		o.NameLocalizations = localizations(o.Name)
+		o.DescriptionLocalizations = localizations(o.Description)
+		for _, c := range o.Choices {
+			c.NameLocalizations = localizations(c.Name)
+		}
+		localizeOptions(o.Options)
+	}
+}
+
+// localizations returns the translation of key for every supported Discord
+// locale that has one
+func localizations(key string) map[discordgo.Locale]string {
+	translated := map[discordgo.Locale]string{}
+	for discordLocale := range steamLanguages {
+		if v := New(discordLocale).Sprintf(key); v != key {
+			translated[discordLocale] = v
+		}
+	}
+	return translated
+}
@@ -0,0 +1,341 @@
+package locale
+
+var german = map[string]string{
+	// Commands
+	"player":                    "spieler",
+	"Fetches player statistics": "Ruft Spielerstatistiken ab",
+	"profile":                   "profil",
+	"Fetches statistics about a players profile": "Ruft Statistiken über das Profil eines Spielers ab",
+	"value":            "wert",
+	"Steam Identifier": "Steam-Kennung",
+	"games":            "spiele",
+	"Fetches statistics about a players game library": "Ruft Statistiken über die Spielebibliothek eines Spielers ab",
+	"bans": "sperren",
+	"Fetches statistics about a players bans histroy": "Ruft den Sperrverlauf eines Spielers ab",
+	"friends": "freunde",
+	"Fetches statistics about a players friends list":  "Ruft Statistiken über die Freundesliste eines Spielers ab",
+	"Fetches multiple formats of the players Steam ID": "Ruft die Steam-ID eines Spielers in mehreren Formaten ab",
+	"game":                             "spiel",
+	"Fetches game information":         "Ruft Spielinformationen ab",
+	"search":                           "suche",
+	"Fetches information about a game": "Ruft Informationen über ein Spiel ab",
+	"Game Identifier":                  "Spielkennung",
+	"price":                            "preis",
+	"Compares the price of a game across regions": "Vergleicht den Preis eines Spiels zwischen Regionen",
+	"regions": "regionen",
+	"Comma separated country codes, e.g. US,GB,DE": "Kommagetrennte Ländercodes, z. B. US,GB,DE",
+	"player-count":                     "spielerzahl",
+	"Fetches player count":             "Ruft die Spielerzahl ab",
+	"news":                             "neuigkeiten",
+	"Fetches latest news about a game": "Ruft die neuesten Nachrichten zu einem Spiel ab",
+	"watch":                            "beobachten",
+	"Notifies you about changes to games and players":             "Benachrichtigt dich über Änderungen an Spielen und Spielern",
+	"Notifies you when a game goes on sale":                       "Benachrichtigt dich, wenn ein Spiel im Angebot ist",
+	"target-price":                                                "zielpreis",
+	"Only notify when the price drops to this amount, e.g. 19.99": "Nur benachrichtigen, wenn der Preis auf diesen Betrag fällt, z. B. 19.99",
+	"notify":                      "benachrichtigung",
+	"Where to send notifications": "Wohin Benachrichtigungen gesendet werden",
+	"This channel":                "Dieser Kanal",
+	"list":                        "liste",
+	"Lists the games and players you are watching": "Listet die Spiele und Spieler auf, die du beobachtest",
+	"remove":                             "entfernen",
+	"Stops watching a game or player":    "Beendet das Beobachten eines Spiels oder Spielers",
+	"Watch ID shown by /watch list":      "Beobachtungs-ID aus /watch list",
+	"settings":                           "einstellungen",
+	"Configures the bot for this server": "Konfiguriert den Bot für diesen Server",
+	"region":                             "region",
+	"Sets the default store region used for prices": "Legt die Standard-Shopregion für Preise fest",
+	"Two letter country code, e.g. US":              "Zweistelliger Ländercode, z. B. US",
+	"group":                                         "gruppe",
+	"Fetches Steam group information":               "Ruft Informationen über Steam-Gruppen ab",
+	"info":                                          "info",
+	"Fetches information about a Steam group":       "Ruft Informationen über eine Steam-Gruppe ab",
+	"Group Identifier":                              "Gruppenkennung",
+	"members":                                       "mitglieder",
+	"List the members of the group":                 "Listet die Mitglieder der Gruppe auf",
+	"page":                                          "seite",
+	"Page of the member list":                       "Seite der Mitgliederliste",
+	"library":                                       "bibliothek",
+	"Lists the games in a players library":          "Listet die Spiele in der Bibliothek eines Spielers auf",
+	"sort":                                          "sortierung",
+	"How to sort the games":                         "Wie die Spiele sortiert werden",
+	"Playtime":                                      "Spielzeit",
+	"Name":                                          "Name",
+	"Recent playtime":                               "Letzte Spielzeit",
+	"filter":                                        "filter",
+	"Which games to show":                           "Welche Spiele angezeigt werden",
+	"Played":                                        "Gespielt",
+	"Unplayed":                                      "Nicht gespielt",
+	"Played on Windows":                             "Unter Windows gespielt",
+	"Played on macOS":                               "Unter macOS gespielt",
+	"Played on Linux":                               "Unter Linux gespielt",
+	"Played on Steam Deck":                          "Auf dem Steam Deck gespielt",
+	"worth":                                         "bibliothekswert",
+	"Estimates the value of a players library": "Schätzt den Wert der Bibliothek eines Spielers",
+	"recent": "kürzlich",
+	"Lists the games a player played in the last 2 weeks":       "Listet die Spiele auf, die ein Spieler in den letzten 2 Wochen gespielt hat",
+	"Notifies you when a player comes online or starts playing": "Benachrichtigt dich, wenn ein Spieler online kommt oder zu spielen beginnt",
+	"Only notify when the player starts or stops this game":     "Nur benachrichtigen, wenn der Spieler dieses Spiel startet oder beendet",
+	"quiet-start": "ruhe-beginn",
+	"quiet-end":   "ruhe-ende",
+	"Hour (UTC) from which no notifications are sent": "Stunde (UTC), ab der keine Benachrichtigungen gesendet werden",
+	"Hour (UTC) at which notifications resume":        "Stunde (UTC), ab der Benachrichtigungen wieder gesendet werden",
+	"banwatch": "bannwache",
+	"Alerts moderators when the bans of a player change": "Benachrichtigt Moderatoren, wenn sich die Banns eines Spielers ändern",
+	"add":                          "hinzufügen",
+	"Watches the bans of a player": "Beobachtet die Banns eines Spielers",
+	"Lists the players whose bans are watched":          "Listet die Spieler auf, deren Banns beobachtet werden",
+	"Stops watching the bans of a player":               "Beendet das Beobachten der Banns eines Spielers",
+	"Watch ID shown by /banwatch list":                  "Beobachtungs-ID aus /banwatch list",
+	"export":                                            "exportieren",
+	"Exports the watched players and their bans as CSV": "Exportiert die beobachteten Spieler und ihre Banns als CSV",
+	"scope":              "umfang",
+	"Whose bans to show": "Wessen Banns angezeigt werden",
+	"Friends":            "Freunde",
+	"mutuals":            "gemeinsame",
+	"Finds the mutual friends of two players": "Findet die gemeinsamen Freunde zweier Spieler",
+	"other":                                "anderer",
+	"Steam Identifier of the other player": "Steam-Kennung des anderen Spielers",
+
+	// Player
+	"Steam ID":                  "Steam-ID",
+	"Steam ID3":                 "Steam-ID3",
+	"Steam ID64":                "Steam-ID64",
+	"Account ID":                "Konto-ID",
+	"Friend Code":               "Freundescode",
+	"Invite Link":               "Einladungslink",
+	"VAC Banned":                "VAC-gesperrt",
+	"# Of VAC Bans":             "Anzahl VAC-Sperren",
+	"# Of Game Bans":            "Anzahl Spielsperren",
+	"Days Since Last Ban":       "Tage seit letzter Sperre",
+	"Community Banned":          "Community-gesperrt",
+	"Economy Banned":            "Handelssperre",
+	"Total Playtime":            "Gesamtspielzeit",
+	"Most Played Game":          "Meistgespieltes Spiel",
+	"Least Played Game":         "Am wenigsten gespieltes Spiel",
+	"Games Owned":               "Spiele im Besitz",
+	"Games Played":              "Gespielte Spiele",
+	"Games Not Played":          "Nicht gespielte Spiele",
+	"Recent Playtime":           "Letzte Spielzeit",
+	"Recent Games Played":       "Kürzlich gespielte Spiele",
+	"Newest":                    "Neuester",
+	"Oldest":                    "Ältester",
+	"Count":                     "Anzahl",
+	"Top 50 Friends":            "Top 50 Freunde",
+	"Friends For":               "Befreundet seit",
+	"Status":                    "Status",
+	"Real Name":                 "Echter Name",
+	"Country Code":              "Ländercode",
+	"State Code":                "Bundeslandcode",
+	"Profile Age":               "Profilalter",
+	"Last Seen":                 "Zuletzt gesehen",
+	"Level":                     "Level",
+	"Level Percentile":          "Level-Perzentil",
+	"Total XP":                  "Gesamt-EP",
+	"XP To Next Level":          "EP bis zum nächsten Level",
+	"Game details are private.": "Die Spieldetails sind privat.",
+	"Friends list is private.":  "Die Freundesliste ist privat.",
+	"This player has not set up their community profile.":                 "Dieser Spieler hat sein Community-Profil nicht eingerichtet.",
+	"Profile is private, real name, location and profile age are hidden.": "Das Profil ist privat, echter Name, Standort und Profilalter sind verborgen.",
+	"unable to retrieve owned games":                                      "Spielebibliothek konnte nicht abgerufen werden",
+	"unable to retrieve friends list":                                     "Freundesliste konnte nicht abgerufen werden",
+	"Detected input: %s":                                                  "Erkannte Eingabe: %s",
+	"SteamID64":                                                           "SteamID64",
+	"account ID":                                                          "Konto-ID",
+	"SteamID2":                                                            "SteamID2",
+	"SteamID3":                                                            "SteamID3",
+	"profile URL":                                                         "Profil-URL",
+	"custom profile URL":                                                  "benutzerdefinierte Profil-URL",
+	"custom URL name":                                                     "benutzerdefinierter URL-Name",
+	"friend code":                                                         "Freundescode",
+	"invite link":                                                         "Einladungslink",
+	"steam:// link":                                                       "steam://-Link",
+	"%s in the last 2 weeks":                                              "%s in den letzten 2 Wochen",
+	"Library (%d games)":                                                  "Bibliothek (%d Spiele)",
+	"Previous":                                                            "Zurück",
+	"Next":                                                                "Weiter",
+	"Platforms":                                                           "Plattformen",
+	"Top Steam Deck Games":                                                "Top-Spiele auf dem Steam Deck",
+	"Top Linux Games":                                                     "Top-Spiele unter Linux",
+	"Windows":                                                             "Windows",
+	"macOS":                                                               "macOS",
+	"Linux":                                                               "Linux",
+	"Steam Deck":                                                          "Steam Deck",
+	"Library Value":                                                       "Wert der Bibliothek",
+	"Current store prices in %s":                                          "Aktuelle Shop-Preise in %s",
+	"%d free games":                                                       "%d kostenlose Spiele",
+	"%d games delisted or not sold in %s":                                 "%d Spiele entfernt oder in %s nicht erhältlich",
+	"None of the games in this library are sold in %s.": "Keines der Spiele in dieser Bibliothek wird in %s verkauft.",
+	"Total Value":                          "Gesamtwert",
+	"Pile of Shame":                        "Pile of Shame",
+	"%s (%d unplayed games)":               "%s (%d ungespielte Spiele)",
+	"Cost per Hour":                        "Kosten pro Stunde",
+	"Priced Games":                         "Spiele mit Preis",
+	"%d of %d":                             "%d von %d",
+	"Not Counted":                          "Nicht gezählt",
+	"Most Expensive Games":                 "Teuerste Spiele",
+	"Recently Played":                      "Kürzlich gespielt",
+	"🎮 Currently playing **%s**":           "🎮 Spielt gerade **%s**",
+	"No games played in the last 2 weeks.": "In den letzten 2 Wochen wurden keine Spiele gespielt.",
+	"%d games played for %s in the last 2 weeks.": "%d Spiele für %s in den letzten 2 Wochen gespielt.",
+	"Showing %d of %d games":                      "%d von %d Spielen angezeigt",
+	"Last 2 Weeks":                                "Letzte 2 Wochen",
+	"Total":                                       "Gesamt",
+	"🟢 Currently in-game":                         "🟢 Gerade im Spiel",
+	"Lobby open to join":                          "Lobby offen zum Beitreten",
+	"Current Game":                                "Aktuelles Spiel",
+	"Server":                                      "Server",
+	"Playing on Steam Deck":                       "Spielt auf dem Steam Deck",
+	"Playing in VR":                               "Spielt in VR",
+	"Playing with Remote Play Together":           "Spielt mit Remote Play Together",
+	"Busy":                                        "Beschäftigt",
+	"Away":                                        "Abwesend",
+	"Snooze":                                      "Schlummern",
+	"Looking to Trade":                            "Möchte tauschen",
+	"Looking to Play":                             "Möchte spielen",
+	"Offline":                                     "Offline",
+	"%s on Steam Deck":                            "%s auf dem Steam Deck",
+	"%s on mobile":                                "%s mobil",
+	"%s on the web":                               "%s im Web",
+	"%s in VR":                                    "%s in VR",
+	"Friend Bans":                                 "Banns der Freunde",
+	"This player has no friends on Steam.":        "Dieser Spieler hat keine Freunde auf Steam.",
+	"Game Banned":                                 "Spielbann",
+	"Banned In Last %d Days":                      "Gebannt in den letzten %d Tagen",
+	"Banned Friends":                              "Gebannte Freunde",
+	"and %d more":                                 "und %d weitere",
+	"%d VAC":                                      "%d VAC",
+	"%d game":                                     "%d Spiel",
+	"community":                                   "Community",
+	"trade":                                       "Handel",
+	"%dd ago":                                     "vor %d T.",
+	"Mutual Friends":                              "Gemeinsame Freunde",
+	"The friends list of %s is private.":          "Die Freundesliste von %s ist privat.",
+	"**%s** and **%s** have %d mutual friends.": "**%s** und **%s** haben %d gemeinsame Freunde.",
+	"They have been friends for %s.":            "Sie sind seit %s befreundet.",
+	"Overlap":                                   "Überschneidung",
+	"Top %d Mutual Friends":                     "Top %d gemeinsame Freunde",
+	"Friends Of %s For":                         "Freunde von %s seit",
+	"%d without a date":                         "%d ohne Datum",
+	"Friends Added Per Year":                    "Neue Freunde pro Jahr",
+	"Busiest Month":                             "Aktivster Monat",
+	"%s (%d friends)":                           "%s (%d Freunde)",
+
+	// Game
+	"Price":         "Preis",
+	"Release Date":  "Erscheinungsdatum",
+	"# DLC":         "Anzahl DLC",
+	"Developers":    "Entwickler",
+	"Publishers":    "Publisher",
+	"Genres":        "Genres",
+	"Free":          "Kostenlos",
+	"Players":       "Spieler",
+	"24h Peak":      "24h-Höchstwert",
+	"All-Time Peak": "Allzeithoch",
+	"This game is free to play in every region.":                                         "Dieses Spiel ist in jeder Region kostenlos.",
+	"Converted prices are approximate and relative to %s. 🏷️ marks the cheapest region.": "Umgerechnete Preise sind ungefähr und beziehen sich auf %s. 🏷️ markiert die günstigste Region.",
+	"Unavailable": "Nicht verfügbar",
+	"Not sold":    "Nicht im Verkauf",
+
+	// Watch
+	"You will be notified when this game goes on sale.": "Du wirst benachrichtigt, wenn dieses Spiel im Angebot ist.",
+	"Current Price":                       "Aktueller Preis",
+	"Target Price":                        "Zielpreis",
+	"Notify":                              "Benachrichtigung",
+	"Watch ID: %s":                        "Beobachtungs-ID: %s",
+	"Watched Games":                       "Beobachtete Spiele",
+	"ID":                                  "ID",
+	"Game":                                "Spiel",
+	"Watch Removed":                       "Beobachtung entfernt",
+	"Watch `%s` has been removed.":        "Beobachtung `%s` wurde entfernt.",
+	"Any discount":                        "Jeder Rabatt",
+	"Direct message":                      "Direktnachricht",
+	"Discount":                            "Rabatt",
+	"Region":                              "Region",
+	"A game you are watching is on sale!": "Ein Spiel, das du beobachtest, ist im Angebot!",
+	"A game you are watching has reached your target price!":                                     "Ein Spiel, das du beobachtest, hat deinen Zielpreis erreicht!",
+	"You will be notified when this player comes online, goes offline or starts playing a game.": "Du wirst benachrichtigt, wenn dieser Spieler online kommt, offline geht oder ein Spiel startet.",
+	"You will be notified when this player starts or stops playing %s.":                          "Du wirst benachrichtigt, wenn dieser Spieler %s startet oder beendet.",
+	"This profile is private, games will only be announced once it is public.":                   "Dieses Profil ist privat, Spiele werden erst angekündigt, wenn es öffentlich ist.",
+	"Quiet Hours":                      "Ruhezeiten",
+	"Any game":                         "Jedes Spiel",
+	"None":                             "Keine",
+	"Watched Players":                  "Beobachtete Spieler",
+	"Player":                           "Spieler",
+	"🟢 **%s** is now online":           "🟢 **%s** ist jetzt online",
+	"⚫ **%s** went offline":            "⚫ **%s** ist offline gegangen",
+	"🎮 **%s** started playing **%s**":  "🎮 **%s** spielt jetzt **%s**",
+	"⏹️ **%s** stopped playing **%s**": "⏹️ **%s** hat **%s** beendet",
+	"The bans of a player you are watching have changed.": "Die Banns eines beobachteten Spielers haben sich geändert.",
+	"VAC Bans":  "VAC-Banns",
+	"Game Bans": "Spiel-Banns",
+	"This channel will be notified when the bans of this player change.": "Dieser Kanal wird benachrichtigt, wenn sich die Banns dieses Spielers ändern.",
+	"%d VAC, %d game":        "%d VAC, %d Spiel",
+	"Ban Watch (%d players)": "Bannwache (%d Spieler)",
+	"Bans":                   "Banns",
+	"Showing %d of %d players, use /banwatch export for the full list": "%d von %d Spielern angezeigt, nutze /banwatch export für die vollständige Liste",
+	"Ban Watch Export":             "Bannwache-Export",
+	"Exported %d watched players.": "%d beobachtete Spieler exportiert.",
+
+	// Settings
+	"Default Region Updated":               "Standardregion aktualisiert",
+	"Store prices are now shown for %s %s": "Shoppreise werden jetzt für %s %s angezeigt",
+
+	// Group
+	"Members":       "Mitglieder",
+	"Online":        "Online",
+	"In-Game":       "Im Spiel",
+	"In Chat":       "Im Chat",
+	"Member List":   "Mitgliederliste",
+	"Page %d of %d": "Seite %d von %d",
+
+	// Errors
+	"the bot is restarting, please try again in a moment": "der Bot wird neu gestartet, bitte versuche es gleich noch einmal",
+	"slow down! try again in %ds":                         "langsam! versuche es in %ds erneut",
+	"the bot is busy, please try again in a moment":       "der Bot ist ausgelastet, bitte versuche es gleich noch einmal",
+	"something went wrong, please try again later":        "etwas ist schiefgelaufen, bitte versuche es später erneut",
+	"you do not have permission to use this command":      "du hast keine Berechtigung, diesen Befehl zu verwenden",
+	"Hint": "Tipp",
+	"this profile is private, ask the user to make their game details and friends list public": "dieses Profil ist privat, bitte die Person, ihre Spieldetails und Freundesliste öffentlich zu machen",
+	"vanity URL not found, check the spelling or use the link to the profile":                  "benutzerdefinierte URL nicht gefunden, überprüfe die Schreibweise oder nutze den Link zum Profil",
+	"no player has this Steam ID, check it with /player id":                                    "kein Spieler hat diese Steam-ID, überprüfe sie mit /player id",
+	"Steam is rate limiting us, please try again in a minute":                                  "Steam drosselt unsere Anfragen, bitte versuche es in einer Minute erneut",
+	"Steam is not responding, it may be down for maintenance":                                  "Steam antwortet nicht, eventuell finden Wartungsarbeiten statt",
+	"check the spelling or use the full name shown on the store page":                          "überprüfe die Schreibweise oder nutze den vollständigen Namen aus dem Shop",
+	"unable to resolve player ID":                                                              "Spieler-ID konnte nicht aufgelöst werden",
+	"unable to retrieve player summary":                                                        "Spielerübersicht konnte nicht abgerufen werden",
+	"game not found":                                                                           "Spiel nicht gefunden",
+	"unable to retrieve game data":                                                             "Spieldaten konnten nicht abgerufen werden",
+	"unable to retrieve game price":                                                            "Spielpreis konnte nicht abgerufen werden",
+	"unable to retrieve game news":                                                             "Spielneuigkeiten konnten nicht abgerufen werden",
+	"unable to retrieve player count":                                                          "Spielerzahl konnte nicht abgerufen werden",
+	"unable to save watch":                                                                     "Beobachtung konnte nicht gespeichert werden",
+	"unable to save region":                                                                    "Region konnte nicht gespeichert werden",
+	"%s is free or not sold in %s":                                                             "%s ist kostenlos oder wird in %s nicht verkauft",
+	"you can watch at most %d games, remove one with /watch remove first":                      "Du kannst höchstens %d Spiele beobachten, entferne zuerst eines mit /watch remove",
+	"invalid target price, expected a number such as 19.99":                                    "Ungültiger Zielpreis, erwartet wird eine Zahl wie 19.99",
+	"unable to remove watch, check the ID with /watch list":                                    "Beobachtung konnte nicht entfernt werden, prüfe die ID mit /watch list",
+	"invalid region, expected two letter country codes such as US, GB, DE":                     "Ungültige Region, erwartet werden zweistellige Ländercodes wie US, GB, DE",
+	"invalid region, expected a two letter country code such as US or GB":                      "Ungültige Region, erwartet wird ein zweistelliger Ländercode wie US oder GB",
+	"unable to resolve group ID":                                                               "Gruppen-ID konnte nicht aufgelöst werden",
+	"unable to retrieve group information":                                                     "Gruppeninformationen konnten nicht abgerufen werden",
+	"unable to retrieve group members":                                                         "Gruppenmitglieder konnten nicht abgerufen werden",
+	"page %d does not exist, this group has %d pages":                                          "Seite %d existiert nicht, diese Gruppe hat %d Seiten",
+	"check the group name or use the link to the group page":                                   "überprüfe den Gruppennamen oder nutze den Link zur Gruppenseite",
+	"use a profile link such as https://steamcommunity.com/id/name or https://steamcommunity.com/profiles/76561197960287930": "nutze einen Profillink wie https://steamcommunity.com/id/name oder https://steamcommunity.com/profiles/76561197960287930",
+	"Steam IDs look like 76561197960287930, STEAM_1:0:11101 or [U:1:22202]":                                                  "Steam-IDs sehen aus wie 76561197960287930, STEAM_1:0:11101 oder [U:1:22202]",
+	"only the person who ran the command can use these buttons":                                                              "nur die Person, die den Befehl ausgeführt hat, kann diese Schaltflächen verwenden",
+	"unable to retrieve game prices":                                         "Spielpreise konnten nicht abgerufen werden",
+	"unable to retrieve recently played games":                               "kürzlich gespielte Spiele konnten nicht abgerufen werden",
+	"you can watch at most %d players, remove one with /watch remove first":  "du kannst höchstens %d Spieler beobachten, entferne zuerst einen mit /watch remove",
+	"this server already watches %d players, which is the limit":             "dieser Server beobachtet bereits %d Spieler, das ist das Limit",
+	"quiet hours need both a start and an end hour":                          "Ruhezeiten benötigen eine Start- und eine Endstunde",
+	"this server already watches the bans of %d players, which is the limit": "dieser Server beobachtet bereits die Banns von %d Spielern, das ist das Limit",
+	"unable to retrieve player bans":                                         "Banns des Spielers konnten nicht abgerufen werden",
+	"this player is already watched, see watch %s":                           "dieser Spieler wird bereits beobachtet, siehe Beobachtung %s",
+	"unable to remove watch, check the ID with /banwatch list":               "Beobachtung konnte nicht entfernt werden, prüfe die ID mit /banwatch list",
+	"unable to export watches":                                               "Beobachtungen konnten nicht exportiert werden",
+	"unable to retrieve friend bans":                                         "Banns der Freunde konnten nicht abgerufen werden",
+	"unable to retrieve friend summaries":                                    "Profile der Freunde konnten nicht abgerufen werden",
+	"pick two different players":                                             "wähle zwei verschiedene Spieler",
+}
@@ -0,0 +1,341 @@
+package locale
+
+var spanish = map[string]string{
+	// Commands
+	"player":                    "jugador",
+	"Fetches player statistics": "Obtiene estadísticas de un jugador",
+	"profile":                   "perfil",
+	"Fetches statistics about a players profile": "Obtiene estadísticas del perfil de un jugador",
+	"value":            "valor",
+	"Steam Identifier": "Identificador de Steam",
+	"games":            "juegos",
+	"Fetches statistics about a players game library": "Obtiene estadísticas de la biblioteca de un jugador",
+	"bans": "baneos",
+	"Fetches statistics about a players bans histroy": "Obtiene el historial de baneos de un jugador",
+	"friends": "amigos",
+	"Fetches statistics about a players friends list":  "Obtiene estadísticas de la lista de amigos de un jugador",
+	"Fetches multiple formats of the players Steam ID": "Obtiene varios formatos del Steam ID de un jugador",
+	"game":                             "juego",
+	"Fetches game information":         "Obtiene información de juegos",
+	"search":                           "buscar",
+	"Fetches information about a game": "Obtiene información sobre un juego",
+	"Game Identifier":                  "Identificador del juego",
+	"price":                            "precio",
+	"Compares the price of a game across regions": "Compara el precio de un juego entre regiones",
+	"regions": "regiones",
+	"Comma separated country codes, e.g. US,GB,DE": "Códigos de país separados por comas, p. ej. US,GB,DE",
+	"player-count":                     "jugadores-activos",
+	"Fetches player count":             "Obtiene el número de jugadores",
+	"news":                             "noticias",
+	"Fetches latest news about a game": "Obtiene las últimas noticias de un juego",
+	"watch":                            "vigilar",
+	"Notifies you about changes to games and players":             "Te avisa de cambios en los juegos y jugadores",
+	"Notifies you when a game goes on sale":                       "Te avisa cuando un juego está en oferta",
+	"target-price":                                                "precio-objetivo",
+	"Only notify when the price drops to this amount, e.g. 19.99": "Solo avisar cuando el precio baje a esta cantidad, p. ej. 19.99",
+	"notify":                      "aviso",
+	"Where to send notifications": "Dónde enviar los avisos",
+	"This channel":                "Este canal",
+	"list":                        "lista",
+	"Lists the games and players you are watching": "Muestra los juegos y jugadores que estás vigilando",
+	"remove":                             "quitar",
+	"Stops watching a game or player":    "Deja de vigilar un juego o jugador",
+	"Watch ID shown by /watch list":      "ID de vigilancia mostrado por /watch list",
+	"settings":                           "ajustes",
+	"Configures the bot for this server": "Configura el bot para este servidor",
+	"region":                             "región",
+	"Sets the default store region used for prices": "Establece la región de la tienda usada para los precios",
+	"Two letter country code, e.g. US":              "Código de país de dos letras, p. ej. US",
+	"group":                                         "grupo",
+	"Fetches Steam group information":               "Obtiene información de grupos de Steam",
+	"info":                                          "info",
+	"Fetches information about a Steam group":       "Obtiene información sobre un grupo de Steam",
+	"Group Identifier":                              "Identificador del grupo",
+	"members":                                       "miembros",
+	"List the members of the group":                 "Lista los miembros del grupo",
+	"page":                                          "pagina",
+	"Page of the member list":                       "Página de la lista de miembros",
+	"library":                                       "biblioteca",
+	"Lists the games in a players library":          "Lista los juegos de la biblioteca de un jugador",
+	"sort":                                          "orden",
+	"How to sort the games":                         "Cómo ordenar los juegos",
+	"Playtime":                                      "Tiempo de juego",
+	"Name":                                          "Nombre",
+	"Recent playtime":                               "Tiempo de juego reciente",
+	"filter":                                        "filtro",
+	"Which games to show":                           "Qué juegos mostrar",
+	"Played":                                        "Jugados",
+	"Unplayed":                                      "No jugados",
+	"Played on Windows":                             "Jugados en Windows",
+	"Played on macOS":                               "Jugados en macOS",
+	"Played on Linux":                               "Jugados en Linux",
+	"Played on Steam Deck":                          "Jugados en Steam Deck",
+	"worth":                                         "valor",
+	"Estimates the value of a players library": "Estima el valor de la biblioteca de un jugador",
+	"recent": "recientes",
+	"Lists the games a player played in the last 2 weeks":       "Lista los juegos que un jugador jugó en las últimas 2 semanas",
+	"Notifies you when a player comes online or starts playing": "Te avisa cuando un jugador se conecta o empieza a jugar",
+	"Only notify when the player starts or stops this game":     "Avisar solo cuando el jugador inicie o cierre este juego",
+	"quiet-start": "silencio-inicio",
+	"quiet-end":   "silencio-fin",
+	"Hour (UTC) from which no notifications are sent": "Hora (UTC) a partir de la cual no se envían avisos",
+	"Hour (UTC) at which notifications resume":        "Hora (UTC) a la que se reanudan los avisos",
+	"banwatch": "vigilar-baneos",
+	"Alerts moderators when the bans of a player change": "Avisa a los moderadores cuando cambian los baneos de un jugador",
+	"add":                          "añadir",
+	"Watches the bans of a player": "Vigila los baneos de un jugador",
+	"Lists the players whose bans are watched":          "Lista los jugadores cuyos baneos se vigilan",
+	"Stops watching the bans of a player":               "Deja de vigilar los baneos de un jugador",
+	"Watch ID shown by /banwatch list":                  "ID de vigilancia mostrado por /banwatch list",
+	"export":                                            "exportar",
+	"Exports the watched players and their bans as CSV": "Exporta los jugadores vigilados y sus baneos como CSV",
+	"scope":              "alcance",
+	"Whose bans to show": "De quién mostrar los baneos",
+	"Friends":            "Amigos",
+	"mutuals":            "comunes",
+	"Finds the mutual friends of two players": "Busca los amigos en común de dos jugadores",
+	"other":                                "otro",
+	"Steam Identifier of the other player": "Identificador de Steam del otro jugador",
+
+	// Player
+	"Steam ID":                  "Steam ID",
+	"Steam ID3":                 "Steam ID3",
+	"Steam ID64":                "Steam ID64",
+	"Account ID":                "ID de cuenta",
+	"Friend Code":               "Código de amigo",
+	"Invite Link":               "Enlace de invitación",
+	"VAC Banned":                "Baneado por VAC",
+	"# Of VAC Bans":             "N.º de baneos VAC",
+	"# Of Game Bans":            "N.º de baneos de juego",
+	"Days Since Last Ban":       "Días desde el último baneo",
+	"Community Banned":          "Baneado de la comunidad",
+	"Economy Banned":            "Baneado de intercambios",
+	"Total Playtime":            "Tiempo de juego total",
+	"Most Played Game":          "Juego más jugado",
+	"Least Played Game":         "Juego menos jugado",
+	"Games Owned":               "Juegos en propiedad",
+	"Games Played":              "Juegos jugados",
+	"Games Not Played":          "Juegos sin jugar",
+	"Recent Playtime":           "Tiempo de juego reciente",
+	"Recent Games Played":       "Juegos jugados recientemente",
+	"Newest":                    "Más reciente",
+	"Oldest":                    "Más antiguo",
+	"Count":                     "Cantidad",
+	"Top 50 Friends":            "Top 50 amigos",
+	"Friends For":               "Amigos desde hace",
+	"Status":                    "Estado",
+	"Real Name":                 "Nombre real",
+	"Country Code":              "Código de país",
+	"State Code":                "Código de estado",
+	"Profile Age":               "Antigüedad del perfil",
+	"Last Seen":                 "Última conexión",
+	"Level":                     "Nivel",
+	"Level Percentile":          "Percentil de nivel",
+	"Total XP":                  "XP total",
+	"XP To Next Level":          "XP para el siguiente nivel",
+	"Game details are private.": "Los detalles de juego son privados.",
+	"Friends list is private.":  "La lista de amigos es privada.",
+	"This player has not set up their community profile.":                 "Este jugador no ha configurado su perfil de la comunidad.",
+	"Profile is private, real name, location and profile age are hidden.": "El perfil es privado, el nombre real, la ubicación y la antigüedad del perfil están ocultos.",
+	"unable to retrieve owned games":                                      "no se pudieron obtener los juegos que posee",
+	"unable to retrieve friends list":                                     "no se pudo obtener la lista de amigos",
+	"Detected input: %s":                                                  "Entrada detectada: %s",
+	"SteamID64":                                                           "SteamID64",
+	"account ID":                                                          "ID de cuenta",
+	"SteamID2":                                                            "SteamID2",
+	"SteamID3":                                                            "SteamID3",
+	"profile URL":                                                         "URL del perfil",
+	"custom profile URL":                                                  "URL de perfil personalizada",
+	"custom URL name":                                                     "nombre de URL personalizada",
+	"friend code":                                                         "código de amigo",
+	"invite link":                                                         "enlace de invitación",
+	"steam:// link":                                                       "enlace steam://",
+	"%s in the last 2 weeks":                                              "%s en las últimas 2 semanas",
+	"Library (%d games)":                                                  "Biblioteca (%d juegos)",
+	"Previous":                                                            "Anterior",
+	"Next":                                                                "Siguiente",
+	"Platforms":                                                           "Plataformas",
+	"Top Steam Deck Games":                                                "Juegos más jugados en Steam Deck",
+	"Top Linux Games":                                                     "Juegos más jugados en Linux",
+	"Windows":                                                             "Windows",
+	"macOS":                                                               "macOS",
+	"Linux":                                                               "Linux",
+	"Steam Deck":                                                          "Steam Deck",
+	"Library Value":                                                       "Valor de la biblioteca",
+	"Current store prices in %s":                                          "Precios actuales de la tienda en %s",
+	"%d free games":                                                       "%d juegos gratuitos",
+	"%d games delisted or not sold in %s":                                 "%d juegos retirados o no vendidos en %s",
+	"None of the games in this library are sold in %s.": "Ninguno de los juegos de esta biblioteca se vende en %s.",
+	"Total Value":                          "Valor total",
+	"Pile of Shame":                        "Pila de la vergüenza",
+	"%s (%d unplayed games)":               "%s (%d juegos sin jugar)",
+	"Cost per Hour":                        "Coste por hora",
+	"Priced Games":                         "Juegos con precio",
+	"%d of %d":                             "%d de %d",
+	"Not Counted":                          "No contados",
+	"Most Expensive Games":                 "Juegos más caros",
+	"Recently Played":                      "Jugados recientemente",
+	"🎮 Currently playing **%s**":           "🎮 Jugando ahora a **%s**",
+	"No games played in the last 2 weeks.": "No ha jugado a ningún juego en las últimas 2 semanas.",
+	"%d games played for %s in the last 2 weeks.": "%d juegos jugados durante %s en las últimas 2 semanas.",
+	"Showing %d of %d games":                      "Mostrando %d de %d juegos",
+	"Last 2 Weeks":                                "Últimas 2 semanas",
+	"Total":                                       "Total",
+	"🟢 Currently in-game":                         "🟢 En partida",
+	"Lobby open to join":                          "Sala abierta para unirse",
+	"Current Game":                                "Juego actual",
+	"Server":                                      "Servidor",
+	"Playing on Steam Deck":                       "Jugando en Steam Deck",
+	"Playing in VR":                               "Jugando en RV",
+	"Playing with Remote Play Together":           "Jugando con Remote Play Together",
+	"Busy":                                        "Ocupado",
+	"Away":                                        "Ausente",
+	"Snooze":                                      "Dormido",
+	"Looking to Trade":                            "Buscando intercambiar",
+	"Looking to Play":                             "Buscando jugar",
+	"Offline":                                     "Desconectado",
+	"%s on Steam Deck":                            "%s en Steam Deck",
+	"%s on mobile":                                "%s en el móvil",
+	"%s on the web":                               "%s en la web",
+	"%s in VR":                                    "%s en RV",
+	"Friend Bans":                                 "Baneos de amigos",
+	"This player has no friends on Steam.":        "Este jugador no tiene amigos en Steam.",
+	"Game Banned":                                 "Baneado de juego",
+	"Banned In Last %d Days":                      "Baneados en los últimos %d días",
+	"Banned Friends":                              "Amigos baneados",
+	"and %d more":                                 "y %d más",
+	"%d VAC":                                      "%d VAC",
+	"%d game":                                     "%d juego",
+	"community":                                   "comunidad",
+	"trade":                                       "intercambio",
+	"%dd ago":                                     "hace %d d",
+	"Mutual Friends":                              "Amigos en común",
+	"The friends list of %s is private.":          "La lista de amigos de %s es privada.",
+	"**%s** and **%s** have %d mutual friends.": "**%s** y **%s** tienen %d amigos en común.",
+	"They have been friends for %s.":            "Son amigos desde hace %s.",
+	"Overlap":                                   "Coincidencia",
+	"Top %d Mutual Friends":                     "Top %d amigos en común",
+	"Friends Of %s For":                         "Amigos de %s desde hace",
+	"%d without a date":                         "%d sin fecha",
+	"Friends Added Per Year":                    "Amigos añadidos por año",
+	"Busiest Month":                             "Mes más activo",
+	"%s (%d friends)":                           "%s (%d amigos)",
+
+	// Game
+	"Price":         "Precio",
+	"Release Date":  "Fecha de lanzamiento",
+	"# DLC":         "N.º de DLC",
+	"Developers":    "Desarrolladores",
+	"Publishers":    "Editores",
+	"Genres":        "Géneros",
+	"Free":          "Gratis",
+	"Players":       "Jugadores",
+	"24h Peak":      "Pico de 24h",
+	"All-Time Peak": "Récord histórico",
+	"This game is free to play in every region.":                                         "Este juego es gratuito en todas las regiones.",
+	"Converted prices are approximate and relative to %s. 🏷️ marks the cheapest region.": "Los precios convertidos son aproximados y relativos a %s. 🏷️ marca la región más barata.",
+	"Unavailable": "No disponible",
+	"Not sold":    "No se vende",
+
+	// Watch
+	"You will be notified when this game goes on sale.": "Recibirás un aviso cuando este juego esté en oferta.",
+	"Current Price":                       "Precio actual",
+	"Target Price":                        "Precio objetivo",
+	"Notify":                              "Aviso",
+	"Watch ID: %s":                        "ID de vigilancia: %s",
+	"Watched Games":                       "Juegos vigilados",
+	"ID":                                  "ID",
+	"Game":                                "Juego",
+	"Watch Removed":                       "Vigilancia eliminada",
+	"Watch `%s` has been removed.":        "La vigilancia `%s` ha sido eliminada.",
+	"Any discount":                        "Cualquier descuento",
+	"Direct message":                      "Mensaje directo",
+	"Discount":                            "Descuento",
+	"Region":                              "Región",
+	"A game you are watching is on sale!": "¡Un juego que vigilas está en oferta!",
+	"A game you are watching has reached your target price!":                                     "¡Un juego que vigilas ha alcanzado tu precio objetivo!",
+	"You will be notified when this player comes online, goes offline or starts playing a game.": "Se te avisará cuando este jugador se conecte, se desconecte o empiece a jugar.",
+	"You will be notified when this player starts or stops playing %s.":                          "Se te avisará cuando este jugador inicie o cierre %s.",
+	"This profile is private, games will only be announced once it is public.":                   "Este perfil es privado, los juegos solo se anunciarán cuando sea público.",
+	"Quiet Hours":                      "Horas de silencio",
+	"Any game":                         "Cualquier juego",
+	"None":                             "Ninguna",
+	"Watched Players":                  "Jugadores vigilados",
+	"Player":                           "Jugador",
+	"🟢 **%s** is now online":           "🟢 **%s** está ahora en línea",
+	"⚫ **%s** went offline":            "⚫ **%s** se ha desconectado",
+	"🎮 **%s** started playing **%s**":  "🎮 **%s** ha empezado a jugar a **%s**",
+	"⏹️ **%s** stopped playing **%s**": "⏹️ **%s** ha dejado de jugar a **%s**",
+	"The bans of a player you are watching have changed.": "Los baneos de un jugador vigilado han cambiado.",
+	"VAC Bans":  "Baneos VAC",
+	"Game Bans": "Baneos de juego",
+	"This channel will be notified when the bans of this player change.": "Este canal recibirá un aviso cuando cambien los baneos de este jugador.",
+	"%d VAC, %d game":        "%d VAC, %d de juego",
+	"Ban Watch (%d players)": "Vigilancia de baneos (%d jugadores)",
+	"Bans":                   "Baneos",
+	"Showing %d of %d players, use /banwatch export for the full list": "Mostrando %d de %d jugadores, usa /banwatch export para la lista completa",
+	"Ban Watch Export":             "Exportación de vigilancia de baneos",
+	"Exported %d watched players.": "%d jugadores vigilados exportados.",
+
+	// Settings
+	"Default Region Updated":               "Región predeterminada actualizada",
+	"Store prices are now shown for %s %s": "Los precios de la tienda ahora se muestran para %s %s",
+
+	// Group
+	"Members":       "Miembros",
+	"Online":        "En línea",
+	"In-Game":       "En juego",
+	"In Chat":       "En el chat",
+	"Member List":   "Lista de miembros",
+	"Page %d of %d": "Página %d de %d",
+
+	// Errors
+	"the bot is restarting, please try again in a moment": "el bot se está reiniciando, inténtalo de nuevo en un momento",
+	"slow down! try again in %ds":                         "¡más despacio! inténtalo de nuevo en %ds",
+	"the bot is busy, please try again in a moment":       "el bot está ocupado, inténtalo de nuevo en un momento",
+	"something went wrong, please try again later":        "algo salió mal, inténtalo de nuevo más tarde",
+	"you do not have permission to use this command":      "no tienes permiso para usar este comando",
+	"Hint": "Sugerencia",
+	"this profile is private, ask the user to make their game details and friends list public": "este perfil es privado, pide al usuario que haga públicos sus detalles de juego y su lista de amigos",
+	"vanity URL not found, check the spelling or use the link to the profile":                  "URL personalizada no encontrada, revisa la ortografía o usa el enlace al perfil",
+	"no player has this Steam ID, check it with /player id":                                    "ningún jugador tiene este Steam ID, compruébalo con /player id",
+	"Steam is rate limiting us, please try again in a minute":                                  "Steam está limitando nuestras solicitudes, inténtalo de nuevo en un minuto",
+	"Steam is not responding, it may be down for maintenance":                                  "Steam no responde, puede estar en mantenimiento",
+	"check the spelling or use the full name shown on the store page":                          "revisa la ortografía o usa el nombre completo de la página de la tienda",
+	"unable to resolve player ID":                                                              "no se pudo resolver el ID del jugador",
+	"unable to retrieve player summary":                                                        "no se pudo obtener el resumen del jugador",
+	"game not found":                                                                           "juego no encontrado",
+	"unable to retrieve game data":                                                             "no se pudieron obtener los datos del juego",
+	"unable to retrieve game price":                                                            "no se pudo obtener el precio del juego",
+	"unable to retrieve game news":                                                             "no se pudieron obtener las noticias del juego",
+	"unable to retrieve player count":                                                          "no se pudo obtener el número de jugadores",
+	"unable to save watch":                                                                     "no se pudo guardar la vigilancia",
+	"unable to save region":                                                                    "no se pudo guardar la región",
+	"%s is free or not sold in %s":                                                             "%s es gratuito o no se vende en %s",
+	"you can watch at most %d games, remove one with /watch remove first":                      "puedes vigilar como máximo %d juegos, quita uno con /watch remove primero",
+	"invalid target price, expected a number such as 19.99":                                    "precio objetivo no válido, se esperaba un número como 19.99",
+	"unable to remove watch, check the ID with /watch list":                                    "no se pudo quitar la vigilancia, comprueba el ID con /watch list",
+	"invalid region, expected two letter country codes such as US, GB, DE":                     "región no válida, se esperaban códigos de país de dos letras como US, GB, DE",
+	"invalid region, expected a two letter country code such as US or GB":                      "región no válida, se esperaba un código de país de dos letras como US o GB",
+	"unable to resolve group ID":                                                               "no se pudo resolver el ID del grupo",
+	"unable to retrieve group information":                                                     "no se pudo obtener la información del grupo",
+	"unable to retrieve group members":                                                         "no se pudieron obtener los miembros del grupo",
+	"page %d does not exist, this group has %d pages":                                          "la página %d no existe, este grupo tiene %d páginas",
+	"check the group name or use the link to the group page":                                   "revisa el nombre del grupo o usa el enlace a la página del grupo",
+	"use a profile link such as https://steamcommunity.com/id/name or https://steamcommunity.com/profiles/76561197960287930": "usa un enlace de perfil como https://steamcommunity.com/id/nombre o https://steamcommunity.com/profiles/76561197960287930",
+	"Steam IDs look like 76561197960287930, STEAM_1:0:11101 or [U:1:22202]":                                                  "los Steam ID tienen el formato 76561197960287930, STEAM_1:0:11101 o [U:1:22202]",
+	"only the person who ran the command can use these buttons":                                                              "solo la persona que ejecutó el comando puede usar estos botones",
+	"unable to retrieve game prices":                                         "no se pudieron obtener los precios de los juegos",
+	"unable to retrieve recently played games":                               "no se pudieron obtener los juegos jugados recientemente",
+	"you can watch at most %d players, remove one with /watch remove first":  "puedes vigilar como máximo %d jugadores, elimina uno con /watch remove primero",
+	"this server already watches %d players, which is the limit":             "este servidor ya vigila %d jugadores, que es el límite",
+	"quiet hours need both a start and an end hour":                          "las horas de silencio necesitan una hora de inicio y una de fin",
+	"this server already watches the bans of %d players, which is the limit": "este servidor ya vigila los baneos de %d jugadores, que es el límite",
+	"unable to retrieve player bans":                                         "no se pudieron obtener los baneos del jugador",
+	"this player is already watched, see watch %s":                           "este jugador ya está vigilado, ver vigilancia %s",
+	"unable to remove watch, check the ID with /banwatch list":               "no se pudo eliminar la vigilancia, comprueba el ID con /banwatch list",
+	"unable to export watches":                                               "no se pudieron exportar las vigilancias",
+	"unable to retrieve friend bans":                                         "no se pudieron obtener los baneos de los amigos",
+	"unable to retrieve friend summaries":                                    "no se pudieron obtener los perfiles de los amigos",
+	"pick two different players":                                             "elige dos jugadores diferentes",
+}
@@ -0,0 +1,341 @@
+package locale
+
+var french = map[string]string{
+	// Commands
+	"player":                    "joueur",
+	"Fetches player statistics": "Récupère les statistiques d'un joueur",
+	"profile":                   "profil",
+	"Fetches statistics about a players profile": "Récupère les statistiques du profil d'un joueur",
+	"value":            "valeur",
+	"Steam Identifier": "Identifiant Steam",
+	"games":            "jeux",
+	"Fetches statistics about a players game library": "Récupère les statistiques de la bibliothèque d'un joueur",
+	"bans": "bannissements",
+	"Fetches statistics about a players bans histroy": "Récupère l'historique des bannissements d'un joueur",
+	"friends": "amis",
+	"Fetches statistics about a players friends list":  "Récupère les statistiques de la liste d'amis d'un joueur",
+	"Fetches multiple formats of the players Steam ID": "Récupère plusieurs formats du Steam ID d'un joueur",
+	"game":                             "jeu",
+	"Fetches game information":         "Récupère les informations d'un jeu",
+	"search":                           "recherche",
+	"Fetches information about a game": "Récupère des informations sur un jeu",
+	"Game Identifier":                  "Identifiant du jeu",
+	"price":                            "prix",
+	"Compares the price of a game across regions": "Compare le prix d'un jeu entre les régions",
+	"regions": "régions",
+	"Comma separated country codes, e.g. US,GB,DE": "Codes pays séparés par des virgules, ex. US,GB,DE",
+	"player-count":                     "nombre-de-joueurs",
+	"Fetches player count":             "Récupère le nombre de joueurs",
+	"news":                             "actualités",
+	"Fetches latest news about a game": "Récupère les dernières actualités d'un jeu",
+	"watch":                            "surveiller",
+	"Notifies you about changes to games and players":             "Vous prévient des changements sur les jeux et les joueurs",
+	"Notifies you when a game goes on sale":                       "Vous prévient quand un jeu est en promotion",
+	"target-price":                                                "prix-cible",
+	"Only notify when the price drops to this amount, e.g. 19.99": "Prévenir seulement quand le prix descend à ce montant, ex. 19.99",
+	"notify":                      "notification",
+	"Where to send notifications": "Où envoyer les notifications",
+	"This channel":                "Ce salon",
+	"list":                        "liste",
+	"Lists the games and players you are watching": "Liste les jeux et les joueurs que vous surveillez",
+	"remove":                             "retirer",
+	"Stops watching a game or player":    "Arrête de surveiller un jeu ou un joueur",
+	"Watch ID shown by /watch list":      "ID de surveillance affiché par /watch list",
+	"settings":                           "paramètres",
+	"Configures the bot for this server": "Configure le bot pour ce serveur",
+	"region":                             "région",
+	"Sets the default store region used for prices": "Définit la région de la boutique utilisée pour les prix",
+	"Two letter country code, e.g. US":              "Code pays à deux lettres, ex. US",
+	"group":                                         "groupe",
+	"Fetches Steam group information":               "Récupère des informations sur les groupes Steam",
+	"info":                                          "info",
+	"Fetches information about a Steam group":       "Récupère des informations sur un groupe Steam",
+	"Group Identifier":                              "Identifiant du groupe",
+	"members":                                       "membres",
+	"List the members of the group":                 "Liste les membres du groupe",
+	"page":                                          "page",
+	"Page of the member list":                       "Page de la liste des membres",
+	"library":                                       "bibliotheque",
+	"Lists the games in a players library":          "Liste les jeux de la bibliothèque d'un joueur",
+	"sort":                                          "tri",
+	"How to sort the games":                         "Comment trier les jeux",
+	"Playtime":                                      "Temps de jeu",
+	"Name":                                          "Nom",
+	"Recent playtime":                               "Temps de jeu récent",
+	"filter":                                        "filtre",
+	"Which games to show":                           "Quels jeux afficher",
+	"Played":                                        "Joués",
+	"Unplayed":                                      "Non joués",
+	"Played on Windows":                             "Joués sur Windows",
+	"Played on macOS":                               "Joués sur macOS",
+	"Played on Linux":                               "Joués sur Linux",
+	"Played on Steam Deck":                          "Joués sur Steam Deck",
+	"worth":                                         "valeur",
+	"Estimates the value of a players library": "Estime la valeur de la bibliothèque d'un joueur",
+	"recent": "recents",
+	"Lists the games a player played in the last 2 weeks":       "Liste les jeux joués par un joueur ces 2 dernières semaines",
+	"Notifies you when a player comes online or starts playing": "Vous prévient quand un joueur se connecte ou commence à jouer",
+	"Only notify when the player starts or stops this game":     "Prévenir uniquement quand le joueur lance ou quitte ce jeu",
+	"quiet-start": "silence-debut",
+	"quiet-end":   "silence-fin",
+	"Hour (UTC) from which no notifications are sent": "Heure (UTC) à partir de laquelle aucune notification n'est envoyée",
+	"Hour (UTC) at which notifications resume":        "Heure (UTC) à laquelle les notifications reprennent",
+	"banwatch": "surveillance-bans",
+	"Alerts moderators when the bans of a player change": "Prévient les modérateurs quand les bannissements d'un joueur changent",
+	"add":                          "ajouter",
+	"Watches the bans of a player": "Surveille les bannissements d'un joueur",
+	"Lists the players whose bans are watched":          "Liste les joueurs dont les bannissements sont surveillés",
+	"Stops watching the bans of a player":               "Arrête de surveiller les bannissements d'un joueur",
+	"Watch ID shown by /banwatch list":                  "ID de surveillance affiché par /banwatch list",
+	"export":                                            "exporter",
+	"Exports the watched players and their bans as CSV": "Exporte les joueurs surveillés et leurs bannissements en CSV",
+	"scope":              "portée",
+	"Whose bans to show": "Les bannissements à afficher",
+	"Friends":            "Amis",
+	"mutuals":            "communs",
+	"Finds the mutual friends of two players": "Trouve les amis communs de deux joueurs",
+	"other":                                "autre",
+	"Steam Identifier of the other player": "Identifiant Steam de l'autre joueur",
+
+	// Player
+	"Steam ID":                  "Steam ID",
+	"Steam ID3":                 "Steam ID3",
+	"Steam ID64":                "Steam ID64",
+	"Account ID":                "ID de compte",
+	"Friend Code":               "Code ami",
+	"Invite Link":               "Lien d'invitation",
+	"VAC Banned":                "Banni VAC",
+	"# Of VAC Bans":             "Nb de bannissements VAC",
+	"# Of Game Bans":            "Nb de bannissements de jeu",
+	"Days Since Last Ban":       "Jours depuis le dernier bannissement",
+	"Community Banned":          "Banni de la communauté",
+	"Economy Banned":            "Banni des échanges",
+	"Total Playtime":            "Temps de jeu total",
+	"Most Played Game":          "Jeu le plus joué",
+	"Least Played Game":         "Jeu le moins joué",
+	"Games Owned":               "Jeux possédés",
+	"Games Played":              "Jeux joués",
+	"Games Not Played":          "Jeux non joués",
+	"Recent Playtime":           "Temps de jeu récent",
+	"Recent Games Played":       "Jeux joués récemment",
+	"Newest":                    "Plus récent",
+	"Oldest":                    "Plus ancien",
+	"Count":                     "Nombre",
+	"Top 50 Friends":            "Top 50 des amis",
+	"Friends For":               "Amis depuis",
+	"Status":                    "Statut",
+	"Real Name":                 "Nom réel",
+	"Country Code":              "Code pays",
+	"State Code":                "Code région",
+	"Profile Age":               "Âge du profil",
+	"Last Seen":                 "Vu pour la dernière fois",
+	"Level":                     "Niveau",
+	"Level Percentile":          "Percentile du niveau",
+	"Total XP":                  "XP totale",
+	"XP To Next Level":          "XP jusqu'au prochain niveau",
+	"Game details are private.": "Les détails de jeu sont privés.",
+	"Friends list is private.":  "La liste d'amis est privée.",
+	"This player has not set up their community profile.":                 "Ce joueur n'a pas configuré son profil de la communauté.",
+	"Profile is private, real name, location and profile age are hidden.": "Le profil est privé, le vrai nom, la localisation et l'âge du profil sont masqués.",
+	"unable to retrieve owned games":                                      "impossible de récupérer les jeux possédés",
+	"unable to retrieve friends list":                                     "impossible de récupérer la liste d'amis",
+	"Detected input: %s":                                                  "Entrée détectée : %s",
+	"SteamID64":                                                           "SteamID64",
+	"account ID":                                                          "ID de compte",
+	"SteamID2":                                                            "SteamID2",
+	"SteamID3":                                                            "SteamID3",
+	"profile URL":                                                         "URL du profil",
+	"custom profile URL":                                                  "URL de profil personnalisée",
+	"custom URL name":                                                     "nom d'URL personnalisée",
+	"friend code":                                                         "code ami",
+	"invite link":                                                         "lien d'invitation",
+	"steam:// link":                                                       "lien steam://",
+	"%s in the last 2 weeks":                                              "%s ces 2 dernières semaines",
+	"Library (%d games)":                                                  "Bibliothèque (%d jeux)",
+	"Previous":                                                            "Précédent",
+	"Next":                                                                "Suivant",
+	"Platforms":                                                           "Plateformes",
+	"Top Steam Deck Games":                                                "Jeux les plus joués sur Steam Deck",
+	"Top Linux Games":                                                     "Jeux les plus joués sur Linux",
+	"Windows":                                                             "Windows",
+	"macOS":                                                               "macOS",
+	"Linux":                                                               "Linux",
+	"Steam Deck":                                                          "Steam Deck",
+	"Library Value":                                                       "Valeur de la bibliothèque",
+	"Current store prices in %s":                                          "Prix actuels du magasin en %s",
+	"%d free games":                                                       "%d jeux gratuits",
+	"%d games delisted or not sold in %s":                                 "%d jeux retirés ou non vendus en %s",
+	"None of the games in this library are sold in %s.": "Aucun jeu de cette bibliothèque n'est vendu en %s.",
+	"Total Value":                          "Valeur totale",
+	"Pile of Shame":                        "Pile de la honte",
+	"%s (%d unplayed games)":               "%s (%d jeux non joués)",
+	"Cost per Hour":                        "Coût par heure",
+	"Priced Games":                         "Jeux payants",
+	"%d of %d":                             "%d sur %d",
+	"Not Counted":                          "Non comptés",
+	"Most Expensive Games":                 "Jeux les plus chers",
+	"Recently Played":                      "Joués récemment",
+	"🎮 Currently playing **%s**":           "🎮 Joue actuellement à **%s**",
+	"No games played in the last 2 weeks.": "Aucun jeu joué ces 2 dernières semaines.",
+	"%d games played for %s in the last 2 weeks.": "%d jeux joués pendant %s ces 2 dernières semaines.",
+	"Showing %d of %d games":                      "%d jeux affichés sur %d",
+	"Last 2 Weeks":                                "2 dernières semaines",
+	"Total":                                       "Total",
+	"🟢 Currently in-game":                         "🟢 En jeu",
+	"Lobby open to join":                          "Salon ouvert",
+	"Current Game":                                "Jeu en cours",
+	"Server":                                      "Serveur",
+	"Playing on Steam Deck":                       "Joue sur Steam Deck",
+	"Playing in VR":                               "Joue en VR",
+	"Playing with Remote Play Together":           "Joue avec Remote Play Together",
+	"Busy":                                        "Occupé",
+	"Away":                                        "Absent",
+	"Snooze":                                      "En veille",
+	"Looking to Trade":                            "Cherche à échanger",
+	"Looking to Play":                             "Cherche à jouer",
+	"Offline":                                     "Hors ligne",
+	"%s on Steam Deck":                            "%s sur Steam Deck",
+	"%s on mobile":                                "%s sur mobile",
+	"%s on the web":                               "%s sur le web",
+	"%s in VR":                                    "%s en VR",
+	"Friend Bans":                                 "Bannissements des amis",
+	"This player has no friends on Steam.":        "Ce joueur n'a aucun ami sur Steam.",
+	"Game Banned":                                 "Banni d'un jeu",
+	"Banned In Last %d Days":                      "Bannis ces %d derniers jours",
+	"Banned Friends":                              "Amis bannis",
+	"and %d more":                                 "et %d de plus",
+	"%d VAC":                                      "%d VAC",
+	"%d game":                                     "%d jeu",
+	"community":                                   "communauté",
+	"trade":                                       "échange",
+	"%dd ago":                                     "il y a %d j",
+	"Mutual Friends":                              "Amis communs",
+	"The friends list of %s is private.":          "La liste d'amis de %s est privée.",
+	"**%s** and **%s** have %d mutual friends.": "**%s** et **%s** ont %d amis communs.",
+	"They have been friends for %s.":            "Ils sont amis depuis %s.",
+	"Overlap":                                   "Chevauchement",
+	"Top %d Mutual Friends":                     "Top %d des amis communs",
+	"Friends Of %s For":                         "Amis de %s depuis",
+	"%d without a date":                         "%d sans date",
+	"Friends Added Per Year":                    "Amis ajoutés par an",
+	"Busiest Month":                             "Mois le plus actif",
+	"%s (%d friends)":                           "%s (%d amis)",
+
+	// Game
+	"Price":         "Prix",
+	"Release Date":  "Date de sortie",
+	"# DLC":         "Nb de DLC",
+	"Developers":    "Développeurs",
+	"Publishers":    "Éditeurs",
+	"Genres":        "Genres",
+	"Free":          "Gratuit",
+	"Players":       "Joueurs",
+	"24h Peak":      "Pic sur 24h",
+	"All-Time Peak": "Record absolu",
+	"This game is free to play in every region.":                                         "Ce jeu est gratuit dans toutes les régions.",
+	"Converted prices are approximate and relative to %s. 🏷️ marks the cheapest region.": "Les prix convertis sont approximatifs et relatifs à %s. 🏷️ indique la région la moins chère.",
+	"Unavailable": "Indisponible",
+	"Not sold":    "Non vendu",
+
+	// Watch
+	"You will be notified when this game goes on sale.": "Vous serez prévenu quand ce jeu sera en promotion.",
+	"Current Price":                       "Prix actuel",
+	"Target Price":                        "Prix cible",
+	"Notify":                              "Notification",
+	"Watch ID: %s":                        "ID de surveillance : %s",
+	"Watched Games":                       "Jeux surveillés",
+	"ID":                                  "ID",
+	"Game":                                "Jeu",
+	"Watch Removed":                       "Surveillance retirée",
+	"Watch `%s` has been removed.":        "La surveillance `%s` a été retirée.",
+	"Any discount":                        "Toute promotion",
+	"Direct message":                      "Message privé",
+	"Discount":                            "Réduction",
+	"Region":                              "Région",
+	"A game you are watching is on sale!": "Un jeu que vous surveillez est en promotion !",
+	"A game you are watching has reached your target price!":                                     "Un jeu que vous surveillez a atteint votre prix cible !",
+	"You will be notified when this player comes online, goes offline or starts playing a game.": "Vous serez prévenu quand ce joueur se connecte, se déconnecte ou lance un jeu.",
+	"You will be notified when this player starts or stops playing %s.":                          "Vous serez prévenu quand ce joueur lance ou quitte %s.",
+	"This profile is private, games will only be announced once it is public.":                   "Ce profil est privé, les jeux ne seront annoncés qu'une fois qu'il sera public.",
+	"Quiet Hours":                      "Heures de silence",
+	"Any game":                         "N'importe quel jeu",
+	"None":                             "Aucune",
+	"Watched Players":                  "Joueurs surveillés",
+	"Player":                           "Joueur",
+	"🟢 **%s** is now online":           "🟢 **%s** est maintenant en ligne",
+	"⚫ **%s** went offline":            "⚫ **%s** s'est déconnecté",
+	"🎮 **%s** started playing **%s**":  "🎮 **%s** a lancé **%s**",
+	"⏹️ **%s** stopped playing **%s**": "⏹️ **%s** a quitté **%s**",
+	"The bans of a player you are watching have changed.": "Les bannissements d'un joueur surveillé ont changé.",
+	"VAC Bans":  "Bannissements VAC",
+	"Game Bans": "Bannissements de jeu",
+	"This channel will be notified when the bans of this player change.": "Ce salon sera prévenu quand les bannissements de ce joueur changent.",
+	"%d VAC, %d game":        "%d VAC, %d de jeu",
+	"Ban Watch (%d players)": "Surveillance des bannissements (%d joueurs)",
+	"Bans":                   "Bannissements",
+	"Showing %d of %d players, use /banwatch export for the full list": "%d joueurs affichés sur %d, utilisez /banwatch export pour la liste complète",
+	"Ban Watch Export":             "Export de la surveillance des bannissements",
+	"Exported %d watched players.": "%d joueurs surveillés exportés.",
+
+	// Settings
+	"Default Region Updated":               "Région par défaut mise à jour",
+	"Store prices are now shown for %s %s": "Les prix de la boutique sont maintenant affichés pour %s %s",
+
+	// Group
+	"Members":       "Membres",
+	"Online":        "En ligne",
+	"In-Game":       "En jeu",
+	"In Chat":       "Dans le chat",
+	"Member List":   "Liste des membres",
+	"Page %d of %d": "Page %d sur %d",
+
+	// Errors
+	"the bot is restarting, please try again in a moment": "le bot redémarre, veuillez réessayer dans un instant",
+	"slow down! try again in %ds":                         "doucement ! réessayez dans %ds",
+	"the bot is busy, please try again in a moment":       "le bot est occupé, veuillez réessayer dans un instant",
+	"something went wrong, please try again later":        "une erreur est survenue, veuillez réessayer plus tard",
+	"you do not have permission to use this command":      "vous n'avez pas la permission d'utiliser cette commande",
+	"Hint": "Astuce",
+	"this profile is private, ask the user to make their game details and friends list public": "ce profil est privé, demandez à l'utilisateur de rendre publics ses détails de jeu et sa liste d'amis",
+	"vanity URL not found, check the spelling or use the link to the profile":                  "URL personnalisée introuvable, vérifiez l'orthographe ou utilisez le lien du profil",
+	"no player has this Steam ID, check it with /player id":                                    "aucun joueur n'a cet identifiant Steam, vérifiez-le avec /player id",
+	"Steam is rate limiting us, please try again in a minute":                                  "Steam limite nos requêtes, veuillez réessayer dans une minute",
+	"Steam is not responding, it may be down for maintenance":                                  "Steam ne répond pas, une maintenance est peut-être en cours",
+	"check the spelling or use the full name shown on the store page":                          "vérifiez l'orthographe ou utilisez le nom complet affiché sur la page du magasin",
+	"unable to resolve player ID":                                                              "impossible de résoudre l'ID du joueur",
+	"unable to retrieve player summary":                                                        "impossible de récupérer le résumé du joueur",
+	"game not found":                                                                           "jeu introuvable",
+	"unable to retrieve game data":                                                             "impossible de récupérer les données du jeu",
+	"unable to retrieve game price":                                                            "impossible de récupérer le prix du jeu",
+	"unable to retrieve game news":                                                             "impossible de récupérer les actualités du jeu",
+	"unable to retrieve player count":                                                          "impossible de récupérer le nombre de joueurs",
+	"unable to save watch":                                                                     "impossible d'enregistrer la surveillance",
+	"unable to save region":                                                                    "impossible d'enregistrer la région",
+	"%s is free or not sold in %s":                                                             "%s est gratuit ou n'est pas vendu en %s",
+	"you can watch at most %d games, remove one with /watch remove first":                      "vous pouvez surveiller au maximum %d jeux, retirez-en un avec /watch remove",
+	"invalid target price, expected a number such as 19.99":                                    "prix cible invalide, un nombre tel que 19.99 est attendu",
+	"unable to remove watch, check the ID with /watch list":                                    "impossible de retirer la surveillance, vérifiez l'ID avec /watch list",
+	"invalid region, expected two letter country codes such as US, GB, DE":                     "région invalide, des codes pays à deux lettres tels que US, GB, DE sont attendus",
+	"invalid region, expected a two letter country code such as US or GB":                      "région invalide, un code pays à deux lettres tel que US ou GB est attendu",
+	"unable to resolve group ID":                                                               "impossible de résoudre l'identifiant du groupe",
+	"unable to retrieve group information":                                                     "impossible de récupérer les informations du groupe",
+	"unable to retrieve group members":                                                         "impossible de récupérer les membres du groupe",
+	"page %d does not exist, this group has %d pages":                                          "la page %d n'existe pas, ce groupe a %d pages",
+	"check the group name or use the link to the group page":                                   "vérifiez le nom du groupe ou utilisez le lien de la page du groupe",
+	"use a profile link such as https://steamcommunity.com/id/name or https://steamcommunity.com/profiles/76561197960287930": "utilisez un lien de profil comme https://steamcommunity.com/id/nom ou https://steamcommunity.com/profiles/76561197960287930",
+	"Steam IDs look like 76561197960287930, STEAM_1:0:11101 or [U:1:22202]":                                                  "les identifiants Steam ressemblent à 76561197960287930, STEAM_1:0:11101 ou [U:1:22202]",
+	"only the person who ran the command can use these buttons":                                                              "seule la personne ayant lancé la commande peut utiliser ces boutons",
+	"unable to retrieve game prices":                                         "impossible de récupérer les prix des jeux",
+	"unable to retrieve recently played games":                               "impossible de récupérer les jeux joués récemment",
+	"you can watch at most %d players, remove one with /watch remove first":  "vous pouvez surveiller au plus %d joueurs, retirez-en un avec /watch remove d'abord",
+	"this server already watches %d players, which is the limit":             "ce serveur surveille déjà %d joueurs, c'est la limite",
+	"quiet hours need both a start and an end hour":                          "les heures de silence nécessitent une heure de début et de fin",
+	"this server already watches the bans of %d players, which is the limit": "ce serveur surveille déjà les bannissements de %d joueurs, c'est la limite",
+	"unable to retrieve player bans":                                         "impossible de récupérer les bannissements du joueur",
+	"this player is already watched, see watch %s":                           "ce joueur est déjà surveillé, voir la surveillance %s",
+	"unable to remove watch, check the ID with /banwatch list":               "impossible de retirer la surveillance, vérifiez l'ID avec /banwatch list",
+	"unable to export watches":                                               "impossible d'exporter les surveillances",
+	"unable to retrieve friend bans":                                         "impossible de récupérer les bannissements des amis",
+	"unable to retrieve friend summaries":                                    "impossible de récupérer les profils des amis",
+	"pick two different players":                                             "choisis deux joueurs différents",
+}
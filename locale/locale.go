@@ -0,0 +1,82 @@
+package locale
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/the-steam-hub/discord-bot/steam"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Locale translates bot responses. Messages are looked up by their English
+// text, which is also returned when no translation exists.
+type Locale struct {
+	discord discordgo.Locale
+	tag     language.Tag
+	printer *message.Printer
+}
+
+var Default = New(discordgo.EnglishUS)
+
+// Steam's language names for the supported languages
+var steamLanguages = map[discordgo.Locale]string{
+	discordgo.EnglishUS:    "english",
+	discordgo.EnglishGB:    "english",
+	discordgo.German:       "german",
+	discordgo.French:       "french",
+	discordgo.SpanishES:    "spanish",
+	discordgo.SpanishLATAM: "latam",
+}
+
+// New creates a Locale for a Discord locale, unsupported locales fall back
+// to English
+func New(discordLocale discordgo.Locale) Locale {
+	if _, ok := steamLanguages[discordLocale]; !ok {
+		discordLocale = discordgo.EnglishUS
+	}
+
+	tag := language.Make(string(discordLocale))
+	return Locale{
+		discord: discordLocale,
+		tag:     tag,
+		printer: message.NewPrinter(tag, message.Catalog(messages)),
+	}
+}
+
+// FromInteraction picks the guild's locale so responses in a channel are in
+// the language of the community, direct messages use the user's locale
+func FromInteraction(interaction *discordgo.Interaction) Locale {
+	if interaction.GuildLocale != nil && *interaction.GuildLocale != "" {
+		return New(*interaction.GuildLocale)
+	}
+	return New(interaction.Locale)
+}
+
+func (l Locale) Sprintf(key string, args ...interface{}) string {
+	return l.printer.Sprintf(key, args...)
+}
+
+// Discord returns the Discord locale, used to persist the locale of
+// background notifications
+func (l Locale) Discord() discordgo.Locale {
+	return l.discord
+}
+
+// StoreLocale derives the language and region of store requests. A guild's
+// configured region takes precedence over the region of the locale.
+//
+// Example: de, "" -> german, DE
+func (l Locale) StoreLocale(region string) steam.StoreLocale {
+	if region == "" {
+		region = steam.DefaultCountryCode
+		if r, confidence := l.tag.Region(); confidence != language.No && r.IsCountry() {
+			region = strings.ToUpper(r.String())
+		}
+	}
+
+	return steam.StoreLocale{
+		Language:    steamLanguages[l.discord],
+		CountryCode: region,
+	}
+}
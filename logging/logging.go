@@ -0,0 +1,127 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+type contextKey struct{}
+
+// Query parameters that must never be written to the logs
+var secretParams = []string{"key"}
+
+// Configure sets the output format ("text" or "json") and minimum level of
+// the standard logger. Empty values keep the defaults.
+func Configure(format string, level string) error {
+	switch strings.ToLower(format) {
+	case "", "text":
+		logrus.SetFormatter(&logrus.TextFormatter{
+			FullTimestamp: true,
+			ForceColors:   true,
+		})
+	case "json":
+		logrus.SetFormatter(&logrus.JSONFormatter{})
+	default:
+		return fmt.Errorf("unknown log format %q", format)
+	}
+
+	if level != "" {
+		l, err := logrus.ParseLevel(level)
+		if err != nil {
+			return err
+		}
+		logrus.SetLevel(l)
+	}
+
+	logrus.SetOutput(os.Stdout)
+	return nil
+}
+
+// NewInteraction creates a logger for a single interaction. Every entry
+// written with it, including the Steam requests made on its behalf, shares
+// the same correlation ID. The returned context is derived from ctx.
+func NewInteraction(ctx context.Context, interaction *discordgo.Interaction, fields logrus.Fields) (context.Context, *logrus.Entry) {
+	logger := logrus.WithFields(logrus.Fields{
+		"correlation_id": uuid.New(),
+		"guild":          interaction.GuildID,
+	})
+
+	if interaction.Member != nil && interaction.Member.User != nil {
+		logger = logger.WithField("author", interaction.Member.User.Username)
+	} else if interaction.User != nil {
+		logger = logger.WithField("author", interaction.User.Username)
+	}
+
+	switch interaction.Type {
+	case discordgo.InteractionApplicationCommand:
+		logger = logger.WithField("command", interaction.ApplicationCommandData().Name)
+	case discordgo.InteractionMessageComponent:
+		logger = logger.WithField("component", interaction.MessageComponentData().CustomID)
+	}
+
+	logger = logger.WithFields(fields)
+	return WithLogger(ctx, logger), logger
+}
+
+// NewBackground creates a logger for work that is not tied to an
+// interaction, such as schedulers
+func NewBackground(ctx context.Context, fields logrus.Fields) (context.Context, *logrus.Entry) {
+	logger := logrus.WithField("correlation_id", uuid.New()).WithFields(fields)
+	return WithLogger(ctx, logger), logger
+}
+
+func WithLogger(ctx context.Context, logger *logrus.Entry) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext returns the logger carried by ctx, or the standard logger if
+// there is none
+func FromContext(ctx context.Context) *logrus.Entry {
+	if logger, ok := ctx.Value(contextKey{}).(*logrus.Entry); ok {
+		return logger
+	}
+	return logrus.NewEntry(logrus.StandardLogger())
+}
+
+// RedactURL removes secrets such as the Steam API key from a URL so it can
+// be logged safely
+//
+// Example: https://api.steampowered.com/?key=ABC&steamid=1 -> https://api.steampowered.com/?key=REDACTED&steamid=1
+func RedactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	params := u.Query()
+	redacted := false
+	for _, v := range secretParams {
+		if params.Has(v) {
+			params.Set(v, "REDACTED")
+			redacted = true
+		}
+	}
+
+	if redacted {
+		u.RawQuery = params.Encode()
+	}
+	return u.String()
+}
+
+// RedactError removes secrets from the URL embedded in errors returned by
+// the http package
+func RedactError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = RedactURL(urlErr.URL)
+	}
+	return err
+}
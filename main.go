@@ -1,24 +1,66 @@
 package main
 
 import (
-	"log"
-	"os"
-	"os/signal"
+	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/cmd/banwatch"
 	"github.com/the-steam-hub/discord-bot/cmd/game"
+	"github.com/the-steam-hub/discord-bot/cmd/group"
 	"github.com/the-steam-hub/discord-bot/cmd/player"
+	"github.com/the-steam-hub/discord-bot/cmd/settings"
+	watchcmd "github.com/the-steam-hub/discord-bot/cmd/watch"
+	"github.com/the-steam-hub/discord-bot/config"
+	"github.com/the-steam-hub/discord-bot/exchange"
+	"github.com/the-steam-hub/discord-bot/health"
+	"github.com/the-steam-hub/discord-bot/lifecycle"
+	"github.com/the-steam-hub/discord-bot/locale"
+	"github.com/the-steam-hub/discord-bot/logging"
+	"github.com/the-steam-hub/discord-bot/metrics"
+	"github.com/the-steam-hub/discord-bot/ratelimit"
 	"github.com/the-steam-hub/discord-bot/steam"
+	"github.com/the-steam-hub/discord-bot/store"
+	"github.com/the-steam-hub/discord-bot/watch"
 )
 
 var discordSession *discordgo.Session
 
 var (
-	steamToken   string
-	discordToken string
-	steamClient  steam.Steam
+	steamClient steam.Steam
+	dataStore   *store.Store
+	// Most players each guild may watch, see config.PlayerWatch and
+	// config.BanWatch
+	playerWatchLimit int
+	banWatchLimit    int
+
+	healthStatus     = &health.Status{}
+	lifecycleManager = lifecycle.New()
+	rateLimiter      *ratelimit.Limiter
+)
+
+const (
+	// How long a Steam heavy command may wait for a free slot, Discord
+	// expects a response within three seconds
+	heavyCommandWait = time.Second
+	// How long the startup check of the Steam API key may take
+	keyCheckTimeout = 10 * time.Second
+)
+
+var (
+	manageGuildPermission int64 = discordgo.PermissionManageServer
+	banMembersPermission  int64 = discordgo.PermissionBanMembers
+	dmPermission                = false
+	minPage                     = 1.0
+	minHour                     = 0.0
+)
+
+const (
+	maxHour = 23
 )
 
 var (
@@ -64,6 +106,22 @@ var (
 							Type:        discordgo.ApplicationCommandOptionString,
 							Required:    true,
 						},
+						{
+							Name:        "scope",
+							Description: "Whose bans to show",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{
+									Name:  "Player",
+									Value: player.BansScopePlayer,
+								},
+								{
+									Name:  "Friends",
+									Value: player.BansScopeFriends,
+								},
+							},
+						},
 					},
 				},
 				{
@@ -92,6 +150,116 @@ var (
 						},
 					},
 				},
+				{
+					Name:        "library",
+					Description: "Lists the games in a players library",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Steam Identifier",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+						{
+							Name:        "sort",
+							Description: "How to sort the games",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{
+									Name:  "Playtime",
+									Value: player.LibrarySortPlaytime,
+								},
+								{
+									Name:  "Name",
+									Value: player.LibrarySortName,
+								},
+								{
+									Name:  "Recent playtime",
+									Value: player.LibrarySortRecent,
+								},
+							},
+						},
+						{
+							Name:        "filter",
+							Description: "Which games to show",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{
+									Name:  "Played",
+									Value: player.LibraryFilterPlayed,
+								},
+								{
+									Name:  "Unplayed",
+									Value: player.LibraryFilterUnplayed,
+								},
+								{
+									Name:  "Played on Windows",
+									Value: string(steam.PlatformWindows),
+								},
+								{
+									Name:  "Played on macOS",
+									Value: string(steam.PlatformMac),
+								},
+								{
+									Name:  "Played on Linux",
+									Value: string(steam.PlatformLinux),
+								},
+								{
+									Name:  "Played on Steam Deck",
+									Value: string(steam.PlatformDeck),
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:        "recent",
+					Description: "Lists the games a player played in the last 2 weeks",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Steam Identifier",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+					},
+				},
+				{
+					Name:        "worth",
+					Description: "Estimates the value of a players library",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Steam Identifier",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+					},
+				},
+				{
+					Name:        "mutuals",
+					Description: "Finds the mutual friends of two players",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Steam Identifier",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+						{
+							Name:        "other",
+							Description: "Steam Identifier of the other player",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -111,6 +279,25 @@ var (
 						},
 					},
 				},
+				{
+					Name:        "price",
+					Description: "Compares the price of a game across regions",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Game Identifier",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+						{
+							Name:        "regions",
+							Description: "Comma separated country codes, e.g. US,GB,DE",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    false,
+						},
+					},
+				},
 				{
 					Name:        "player-count",
 					Description: "Fetches player count",
@@ -139,102 +326,450 @@ var (
 				},
 			},
 		},
+		{
+			Name:        "group",
+			Description: "Fetches Steam group information",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Name:        "info",
+					Description: "Fetches information about a Steam group",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Group Identifier",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+						{
+							Name:        "members",
+							Description: "List the members of the group",
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Required:    false,
+						},
+						{
+							Name:        "page",
+							Description: "Page of the member list",
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Required:    false,
+							MinValue:    &minPage,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:         "watch",
+			Description:  "Notifies you about changes to games and players",
+			DMPermission: &dmPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Name:        "price",
+					Description: "Notifies you when a game goes on sale",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Game Identifier",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+						{
+							Name:        "target-price",
+							Description: "Only notify when the price drops to this amount, e.g. 19.99",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    false,
+						},
+						{
+							Name:        "notify",
+							Description: "Where to send notifications",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{
+									Name:  "Direct message",
+									Value: "dm",
+								},
+								{
+									Name:  "This channel",
+									Value: "channel",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:        "player",
+					Description: "Notifies you when a player comes online or starts playing",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Steam Identifier",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+						{
+							Name:        "game",
+							Description: "Only notify when the player starts or stops this game",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    false,
+						},
+						{
+							Name:        "notify",
+							Description: "Where to send notifications",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{
+									Name:  "Direct message",
+									Value: "dm",
+								},
+								{
+									Name:  "This channel",
+									Value: "channel",
+								},
+							},
+						},
+						{
+							Name:        "quiet-start",
+							Description: "Hour (UTC) from which no notifications are sent",
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Required:    false,
+							MinValue:    &minHour,
+							MaxValue:    maxHour,
+						},
+						{
+							Name:        "quiet-end",
+							Description: "Hour (UTC) at which notifications resume",
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Required:    false,
+							MinValue:    &minHour,
+							MaxValue:    maxHour,
+						},
+					},
+				},
+				{
+					Name:        "list",
+					Description: "Lists the games and players you are watching",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+				},
+				{
+					Name:        "remove",
+					Description: "Stops watching a game or player",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Watch ID shown by /watch list",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:                     "banwatch",
+			Description:              "Alerts moderators when the bans of a player change",
+			DefaultMemberPermissions: &banMembersPermission,
+			DMPermission:             &dmPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Name:        "add",
+					Description: "Watches the bans of a player",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Steam Identifier",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+					},
+				},
+				{
+					Name:        "list",
+					Description: "Lists the players whose bans are watched",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+				},
+				{
+					Name:        "remove",
+					Description: "Stops watching the bans of a player",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Watch ID shown by /banwatch list",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+					},
+				},
+				{
+					Name:        "export",
+					Description: "Exports the watched players and their bans as CSV",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+				},
+			},
+		},
+		{
+			Name:                     "settings",
+			Description:              "Configures the bot for this server",
+			DefaultMemberPermissions: &manageGuildPermission,
+			DMPermission:             &dmPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Name:        "region",
+					Description: "Sets the default store region used for prices",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "value",
+							Description: "Two letter country code, e.g. US",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
 	}
 
-	commandHandler = map[string]func(s *discordgo.Session, i *discordgo.InteractionCreate){
-		"player": func(s *discordgo.Session, i *discordgo.InteractionCreate) {
-			for _, o := range i.ApplicationCommandData().Options {
-				v := o.Options[0].StringValue()
-				switch o.Name {
-				case "profile":
-					player.PlayerProfile(s, i, steamClient, v)
-				case "games":
-					player.PlayerGames(s, i, steamClient, v)
-				case "friends":
-					player.PlayerFriends(s, i, steamClient, v)
-				case "bans":
-					player.PlayerBans(s, i, steamClient, v)
-				case "id":
-					player.PlayerID(s, i, steamClient, v)
-				}
-			}
+	// Handlers keyed by command path, see cmd.CommandPath
+	commandHandlers = map[string]cmd.Handler{
+		"player profile": func(ctx context.Context, i *cmd.Interaction) error {
+			return player.PlayerProfile(ctx, i, steamClient, i.OptionString("value"))
+		},
+		"player games": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return player.PlayerGames(ctx, i, steamClient, i.OptionString("value"))
+		}, cmd.Defer()),
+		"player friends": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return player.PlayerFriends(ctx, i, steamClient, i.OptionString("value"))
+		}, cmd.Defer()),
+		"player bans": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return player.PlayerBans(ctx, i, steamClient, i.OptionString("value"), i.OptionString("scope"))
+		}, cmd.Defer()),
+		"player id": func(ctx context.Context, i *cmd.Interaction) error {
+			return player.PlayerID(ctx, i, steamClient, i.OptionString("value"))
+		},
+		"player library": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return player.PlayerLibrary(ctx, i, steamClient, i.OptionString("value"), i.OptionString("sort"), i.OptionString("filter"))
+		}, cmd.Defer()),
+		"player recent": func(ctx context.Context, i *cmd.Interaction) error {
+			return player.PlayerRecent(ctx, i, steamClient, i.OptionString("value"))
+		},
+		"player worth": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return player.PlayerWorth(ctx, i, steamClient, i.OptionString("value"), guildRegion(i))
+		}, cmd.Defer()),
+		"player mutuals": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return player.PlayerMutuals(ctx, i, steamClient, i.OptionString("value"), i.OptionString("other"))
+		}, cmd.Defer()),
+		"game search": func(ctx context.Context, i *cmd.Interaction) error {
+			return game.AppSearch(ctx, i, steamClient, i.OptionString("value"), guildRegion(i))
+		},
+		"game price": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return game.AppPrice(ctx, i, steamClient, i.OptionString("value"), i.OptionString("regions"), guildRegion(i))
+		}, cmd.Defer()),
+		"game player-count": func(ctx context.Context, i *cmd.Interaction) error {
+			return game.AppPlayerCount(ctx, i, steamClient, i.OptionString("value"), guildRegion(i))
+		},
+		"game news": func(ctx context.Context, i *cmd.Interaction) error {
+			return game.AppNews(ctx, i, steamClient, i.OptionString("value"), guildRegion(i))
+		},
+		"group info": func(ctx context.Context, i *cmd.Interaction) error {
+			return group.GroupInfo(ctx, i, steamClient, i.OptionString("value"), i.OptionBool("members"), int(i.OptionInt("page", 1)))
+		},
+		"watch price": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return watchcmd.PriceAdd(ctx, i, steamClient, dataStore, i.OptionString("value"), i.OptionString("target-price"), i.OptionString("notify"), guildRegion(i))
+		}, cmd.Defer()),
+		"watch player": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return watchcmd.PlayerAdd(ctx, i, steamClient, dataStore, playerWatchLimit, i.OptionString("value"), i.OptionString("game"), i.OptionString("notify"),
+				int(i.OptionInt("quiet-start", watchcmd.NoQuietHour)), int(i.OptionInt("quiet-end", watchcmd.NoQuietHour)), guildRegion(i))
+		}, cmd.Defer()),
+		"watch list": func(ctx context.Context, i *cmd.Interaction) error {
+			return watchcmd.List(ctx, i, dataStore)
 		},
-		"game": func(s *discordgo.Session, i *discordgo.InteractionCreate) {
-			for _, o := range i.ApplicationCommandData().Options {
-				v := o.Options[0].StringValue()
-				switch o.Name {
-				case "search":
-					game.AppSearch(s, i, steamClient, v)
-				case "player-count":
-					game.AppPlayerCount(s, i, steamClient, v)
-				case "news":
-					game.AppNews(s, i, steamClient, v)
-				}
-			}
+		"watch remove": func(ctx context.Context, i *cmd.Interaction) error {
+			return watchcmd.Remove(ctx, i, dataStore, i.OptionString("value"))
 		},
+		"banwatch add": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return banwatch.Add(ctx, i, steamClient, dataStore, banWatchLimit, i.OptionString("value"))
+		}, cmd.RequirePermissions(banMembersPermission)),
+		"banwatch list": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return banwatch.List(ctx, i, dataStore)
+		}, cmd.RequirePermissions(banMembersPermission)),
+		"banwatch remove": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return banwatch.Remove(ctx, i, dataStore, i.OptionString("value"))
+		}, cmd.RequirePermissions(banMembersPermission)),
+		"banwatch export": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return banwatch.Export(ctx, i, dataStore)
+		}, cmd.RequirePermissions(banMembersPermission)),
+		"settings region": cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return settings.Region(ctx, i, dataStore, i.OptionString("value"))
+		}, cmd.RequirePermissions(manageGuildPermission)),
 	}
-)
-
-func init() {
-	logrus.SetFormatter(&logrus.TextFormatter{
-		FullTimestamp: true,
-		ForceColors:   true,
-	})
-}
 
-func init() {
-	env := os.Getenv("BOT_ENV")
-	if env == "" {
-		env = "development"
+	// Handlers of message components keyed by the name in their custom ID,
+	// see cmd.ComponentID
+	componentHandlers = map[string]cmd.Handler{
+		player.LibraryComponent: cmd.Chain(func(ctx context.Context, i *cmd.Interaction) error {
+			return player.PlayerLibraryPage(ctx, i, steamClient)
+		}, cmd.RequireInvoker(), cmd.Defer()),
 	}
+)
 
+func main() {
 	logrus.Info("loading configurations...")
-	err := godotenv.Load(".env." + env)
+	cfg, err := config.Load()
 	if err != nil {
-		logrus.Fatalf("error loading .env file: %s", err)
+		logrus.Fatalf("invalid configuration: %s", err)
 	}
-	logrus.Infof("launching in %s mode...", env)
 
-	steamToken = os.Getenv("STEAM_API_KEY")
-	discordToken = os.Getenv("DISCORD_BOT_TOKEN")
-	steamClient = steam.Steam{Key: steamToken}
+	err = setup(cfg)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	err = run(cfg)
+	if err != nil {
+		logrus.Fatal(err)
+	}
 }
 
-func init() {
+// setup creates the clients used by the command handlers and the Discord
+// session, nothing is connected yet
+func setup(cfg config.Config) error {
+	err := logging.Configure(cfg.Log.Format, cfg.Log.Level)
+	if err != nil {
+		return fmt.Errorf("error configuring logging: %w", err)
+	}
+	logrus.Infof("launching in %s mode...", cfg.Env)
+
+	steamClient = steam.New(cfg.SteamAPIKey, steam.Options{
+		Timeout:       cfg.Timeouts.Steam,
+		PriceCacheTTL: cfg.Cache.Prices,
+	})
+	exchange.SetCacheTTL(cfg.Cache.ExchangeRates)
+	exchange.SetTimeout(cfg.Timeouts.Steam)
+
+	if !cfg.Features.SkipKeyCheck {
+		logrus.Info("validating Steam API key...")
+		keyCtx, cancel := context.WithTimeout(context.Background(), keyCheckTimeout)
+		err = steamClient.ValidateKey(keyCtx)
+		cancel()
+		// Only a rejected key is fatal, an outage must not keep the bot
+		// from starting
+		if errors.Is(err, steam.ErrInvalidKey) {
+			return fmt.Errorf("error validating Steam API key: %w", err)
+		}
+		if err != nil {
+			logrus.WithError(err).Warn("unable to validate Steam API key, continuing")
+		}
+	}
+
+	rateLimiter = ratelimit.New(ratelimit.Options{
+		UserCooldown:     cfg.RateLimit.UserCooldown,
+		GuildCooldown:    cfg.RateLimit.GuildCooldown,
+		CommandCooldowns: cfg.RateLimit.Commands,
+		MaxConcurrent:    cfg.RateLimit.MaxConcurrent,
+		HeavyCommands:    cfg.RateLimit.HeavyCommands,
+	})
+
+	dataStore, err = store.Open(cfg.DataFile)
+	if err != nil {
+		return fmt.Errorf("error loading data file: %w", err)
+	}
+	playerWatchLimit = cfg.PlayerWatch.MaxPerGuild
+	banWatchLimit = cfg.BanWatch.MaxPerGuild
+
 	logrus.Info("creating Discord session...")
-	var err error
-	discordSession, err = discordgo.New("Bot " + discordToken)
+	discordSession, err = discordgo.New("Bot " + cfg.DiscordToken)
 	if err != nil {
-		logrus.Fatalf("error creating Discord session: %s", err)
+		return fmt.Errorf("error creating Discord session: %w", err)
+	}
+
+	middlewares := []cmd.Middleware{
+		cmd.Log(),
+		cmd.Recover(),
+		cmd.Drain(lifecycleManager),
+		cmd.RateLimit(rateLimiter, heavyCommandWait),
+		// After the rate limiter so rejected commands are not counted as failed
+		cmd.Metrics(),
+	}
+	handlers := make(map[string]cmd.Handler, len(commandHandlers))
+	for path, h := range commandHandlers {
+		handlers[path] = cmd.Chain(h, middlewares...)
+	}
+	components := make(map[string]cmd.Handler, len(componentHandlers))
+	for name, h := range componentHandlers {
+		components[name] = cmd.Chain(h, middlewares...)
 	}
 
 	discordSession.AddHandler(func(s *discordgo.Session, i *discordgo.InteractionCreate) {
-		if h, ok := commandHandler[i.ApplicationCommandData().Name]; ok {
-			h(s, i)
+		var h cmd.Handler
+		switch i.Type {
+		case discordgo.InteractionApplicationCommand:
+			h = handlers[cmd.CommandPath(i.Interaction)]
+		case discordgo.InteractionMessageComponent:
+			h = components[cmd.ComponentName(i.Interaction)]
+		}
+
+		if h != nil {
+			cmd.Dispatch(lifecycleManager.InteractionContext(), s, i, h)
 		}
 	})
 
+	discordSession.AddHandler(func(s *discordgo.Session, event *discordgo.Connect) {
+		healthStatus.SetSessionOpen(true)
+	})
+
+	discordSession.AddHandler(func(s *discordgo.Session, event *discordgo.Disconnect) {
+		healthStatus.SetSessionOpen(false)
+	})
+
+	metrics.RegisterGatewayLatency(discordSession.HeartbeatLatency)
+
 	discordSession.AddHandler(func(s *discordgo.Session, event *discordgo.Ready) {
 		logrus.Infof("logging in as %s#%s", s.State.User.Username, s.State.User.Discriminator)
 	})
+
+	return nil
 }
 
-func main() {
+// guildRegion returns the store region configured for the guild the
+// interaction was sent from
+func guildRegion(i *cmd.Interaction) string {
+	return dataStore.GuildSettings(i.GuildID).Region
+}
+
+// run connects to Discord, registers the commands and blocks until the
+// process is asked to shut down
+func run(cfg config.Config) error {
+	if cfg.MetricsAddr != "" {
+		metricsServer := health.NewServer(cfg.MetricsAddr, healthStatus)
+		metricsServer.Start()
+		lifecycleManager.OnShutdown("metrics server", metricsServer.Shutdown)
+	}
+
 	logrus.Info("opening websocket connection to Discord...")
 	err := discordSession.Open()
 	if err != nil {
-		logrus.Fatalf("error opening connection: %s", err)
+		return fmt.Errorf("error opening connection: %w", err)
 	}
 
+	locale.LocalizeCommands(commands)
+
 	registeredCommands := make([]*discordgo.ApplicationCommand, len(commands))
 	for i, v := range commands {
 		cmd, err := discordSession.ApplicationCommandCreate(discordSession.State.User.ID, "", v)
 		logrus.Infof("creating command: %s", v.Name)
 		if err != nil {
-			logrus.Fatalf("cannot create %s command: %s", v.Name, err)
+			return fmt.Errorf("cannot create %s command: %w", v.Name, err)
 		}
 		registeredCommands[i] = cmd
 	}
+	healthStatus.SetCommandsRegistered(true)
 
 	err = discordSession.UpdateStatusComplex(discordgo.UpdateStatusData{
 		Activities: []*discordgo.Activity{
@@ -246,13 +781,51 @@ func main() {
 	})
 
 	if err != nil {
-		log.Fatalf("cannot set status: %v", err)
+		return fmt.Errorf("cannot set status: %w", err)
+	}
+
+	if cfg.Features.PriceWatch {
+		priceScheduler := watch.PriceScheduler{
+			Session:  discordSession,
+			Steam:    steamClient,
+			Store:    dataStore,
+			Interval: cfg.PriceWatch.Interval,
+		}
+		lifecycleManager.Go("price scheduler", priceScheduler.Run)
+	}
+
+	if cfg.Features.PlayerWatch {
+		playerScheduler := watch.PlayerScheduler{
+			Session:  discordSession,
+			Steam:    steamClient,
+			Store:    dataStore,
+			Interval: cfg.PlayerWatch.Interval,
+		}
+		lifecycleManager.Go("player scheduler", playerScheduler.Run)
+	}
+
+	if cfg.Features.BanWatch {
+		banScheduler := watch.BanScheduler{
+			Session:  discordSession,
+			Steam:    steamClient,
+			Store:    dataStore,
+			Interval: cfg.BanWatch.Interval,
+		}
+		lifecycleManager.Go("ban scheduler", banScheduler.Run)
 	}
 
+	lifecycleManager.OnShutdown("data store", func(ctx context.Context) error {
+		return dataStore.Flush()
+	})
+	// The gateway is closed last so in-flight handlers can still respond
+	lifecycleManager.OnShutdown("Discord session", func(ctx context.Context) error {
+		return discordSession.Close()
+	})
+
 	logrus.Info("Steam Stats is now running. Press CTRL+C to exit.")
-	defer discordSession.Close()
+	lifecycleManager.WaitForSignal()
 
-	stop := make(chan os.Signal, 1)
-	signal.Notify(stop, os.Interrupt)
-	<-stop
+	healthStatus.SetCommandsRegistered(false)
+	lifecycleManager.Shutdown(cfg.Timeouts.Shutdown)
+	return nil
 }
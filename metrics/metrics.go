@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "steambot"
+
+var (
+	commandInvocations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "command_invocations_total",
+		Help:      "Number of slash commands invoked.",
+	}, []string{"command", "subcommand"})
+
+	commandErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "command_errors_total",
+		Help:      "Number of slash commands answered with an error.",
+	}, []string{"command", "subcommand"})
+
+	commandDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "command_duration_seconds",
+		Help:      "Time taken to handle a slash command.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2, 5, 10, 30},
+	}, []string{"command", "subcommand"})
+
+	steamRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "steam_requests_total",
+		Help:      "Number of requests made to Steam, by endpoint and HTTP status. Status is 0 when no response was received.",
+	}, []string{"endpoint", "status"})
+
+	steamRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "steam_request_duration_seconds",
+		Help:      "Latency of requests made to Steam.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"endpoint"})
+
+	cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_requests_total",
+		Help:      "Number of cache lookups, by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+)
+
+// ObserveCommand records the invocation and duration of a slash command
+func ObserveCommand(interaction *discordgo.Interaction, start time.Time) {
+	command, subcommand := commandLabels(interaction)
+	commandInvocations.WithLabelValues(command, subcommand).Inc()
+	commandDuration.WithLabelValues(command, subcommand).Observe(time.Since(start).Seconds())
+}
+
+func CommandError(interaction *discordgo.Interaction) {
+	command, subcommand := commandLabels(interaction)
+	commandErrors.WithLabelValues(command, subcommand).Inc()
+}
+
+func ObserveSteamRequest(endpoint string, status int, latency time.Duration) {
+	steamRequests.WithLabelValues(endpoint, strconv.Itoa(status)).Inc()
+	steamRequestDuration.WithLabelValues(endpoint).Observe(latency.Seconds())
+}
+
+func CacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	cacheRequests.WithLabelValues(cache, result).Inc()
+}
+
+// RegisterGatewayLatency exposes the latency of the Discord gateway
+// heartbeat, which is read whenever the metrics are scraped
+func RegisterGatewayLatency(latency func() time.Duration) {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "gateway_latency_seconds",
+		Help:      "Latency of the last Discord gateway heartbeat.",
+	}, func() float64 {
+		return latency().Seconds()
+	})
+}
+
+func commandLabels(interaction *discordgo.Interaction) (string, string) {
+	switch interaction.Type {
+	case discordgo.InteractionApplicationCommand:
+	case discordgo.InteractionMessageComponent:
+		// Custom IDs carry arguments after the component name
+		name, _, _ := strings.Cut(interaction.MessageComponentData().CustomID, ":")
+		return "component", name
+	default:
+		return "", ""
+	}
+
+	data := interaction.ApplicationCommandData()
+	for _, o := range data.Options {
+		if o.Type == discordgo.ApplicationCommandOptionSubCommand {
+			return data.Name, o.Name
+		}
+	}
+	return data.Name, ""
+}
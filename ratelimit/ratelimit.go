@@ -0,0 +1,131 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter enforces cooldowns between commands and caps the number of Steam
+// heavy commands running at the same time. Cooldowns apply per user across
+// all commands, per guild across all users, and per user for individual
+// commands.
+type Limiter struct {
+	mu               sync.Mutex
+	userCooldown     time.Duration
+	guildCooldown    time.Duration
+	commandCooldowns map[string]time.Duration
+	maxCooldown      time.Duration
+	last             map[string]time.Time
+
+	heavy         chan struct{}
+	heavyCommands map[string]bool
+}
+
+type Options struct {
+	UserCooldown  time.Duration
+	GuildCooldown time.Duration
+	// CommandCooldowns are keyed by the command path, e.g. "player friends"
+	CommandCooldowns map[string]time.Duration
+	// MaxConcurrent caps the heavy commands in flight, zero disables the cap
+	MaxConcurrent int
+	HeavyCommands []string
+}
+
+// Entries are pruned once the map grows past this size
+const pruneThreshold = 1024
+
+func New(opts Options) *Limiter {
+	l := &Limiter{
+		userCooldown:     opts.UserCooldown,
+		guildCooldown:    opts.GuildCooldown,
+		commandCooldowns: opts.CommandCooldowns,
+		maxCooldown:      max(opts.UserCooldown, opts.GuildCooldown),
+		last:             map[string]time.Time{},
+		heavyCommands:    map[string]bool{},
+	}
+
+	for _, v := range opts.CommandCooldowns {
+		l.maxCooldown = max(l.maxCooldown, v)
+	}
+
+	if opts.MaxConcurrent > 0 {
+		l.heavy = make(chan struct{}, opts.MaxConcurrent)
+	}
+
+	for _, v := range opts.HeavyCommands {
+		l.heavyCommands[v] = true
+	}
+
+	return l
+}
+
+// Allow reports whether the user may run the command. When they may not,
+// the time until the longest cooldown expires is returned. Allowed commands
+// start new cooldowns.
+func (l *Limiter) Allow(userID string, guildID string, command string) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	keys := map[string]time.Duration{
+		"user:" + userID:                    l.userCooldown,
+		"command:" + userID + ":" + command: l.commandCooldowns[command],
+	}
+	if guildID != "" {
+		keys["guild:"+guildID] = l.guildCooldown
+	}
+
+	var wait time.Duration
+	for key, cooldown := range keys {
+		if last, ok := l.last[key]; ok && cooldown > 0 {
+			wait = max(wait, cooldown-now.Sub(last))
+		}
+	}
+
+	if wait > 0 {
+		return wait, false
+	}
+
+	for key, cooldown := range keys {
+		if cooldown > 0 {
+			l.last[key] = now
+		}
+	}
+
+	if len(l.last) > pruneThreshold {
+		l.prune(now)
+	}
+
+	return 0, true
+}
+
+// Heavy reports whether the command counts towards the concurrency cap
+func (l *Limiter) Heavy(command string) bool {
+	return l.heavy != nil && l.heavyCommands[command]
+}
+
+// Acquire waits for a free slot until ctx is done. It returns false when no
+// slot became available, otherwise Release must be called when done.
+func (l *Limiter) Acquire(ctx context.Context) bool {
+	select {
+	case l.heavy <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (l *Limiter) Release() {
+	<-l.heavy
+}
+
+// prune removes entries whose cooldowns have all expired. The caller must
+// hold the lock.
+func (l *Limiter) prune(now time.Time) {
+	for key, last := range l.last {
+		if now.Sub(last) > l.maxCooldown {
+			delete(l.last, key)
+		}
+	}
+}
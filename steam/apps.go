@@ -1,20 +1,44 @@
 package steam
 
 import (
+	"cmp"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gocolly/colly"
+	"github.com/the-steam-hub/discord-bot/cache"
 )
 
 type Steam struct {
-	Key string
+	Key    string
+	client *http.Client
+	prices *cache.Cache[string, appPrice]
+}
+
+type Options struct {
+	// Timeout bounds every request made to Steam
+	Timeout       time.Duration
+	PriceCacheTTL time.Duration
+}
+
+func New(key string, opts Options) Steam {
+	return Steam{
+		Key: key,
+		client: &http.Client{
+			Timeout: opts.Timeout,
+		},
+		prices: cache.New[string, appPrice]("app_prices", opts.PriceCacheTTL),
+	}
 }
 
 type AppPlayerCount struct {
@@ -47,20 +71,16 @@ type AppNews struct {
 }
 
 type AppDetailedData struct {
-	Name             string   `json:"name"`
-	AppID            int      `json:"steam_appid"`
-	ShortDescription string   `json:"short_description"`
-	Developers       []string `json:"developers"`
-	Publishers       []string `json:"publishers"`
-	HeaderImage      string   `json:"header_image"`
-	IsFree           bool     `json:"is_free"`
-	DLC              []string `json:"dlc"`
-	PriceOverview    struct {
-		FinalFormatted   string `json:"final_formatted"`
-		InitialFormatted string `json:"initial_formatted"`
-		DiscountPercent  int    `json:"discount_percent"`
-	} `json:"price_overview"`
-	ReleaseDate struct {
+	Name             string           `json:"name"`
+	AppID            int              `json:"steam_appid"`
+	ShortDescription string           `json:"short_description"`
+	Developers       []string         `json:"developers"`
+	Publishers       []string         `json:"publishers"`
+	HeaderImage      string           `json:"header_image"`
+	IsFree           bool             `json:"is_free"`
+	DLC              []string         `json:"dlc"`
+	PriceOverview    AppPriceOverview `json:"price_overview"`
+	ReleaseDate      struct {
 		ComingSoon bool   `json:"coming_soon"`
 		Date       string `json:"date"`
 	} `json:"release_date"`
@@ -70,6 +90,40 @@ type AppDetailedData struct {
 	} `json:"genres"`
 }
 
+// AppPriceOverview holds the store price of an app in a single region.
+// Initial and Final are expressed in the currencies minor unit, e.g. cents
+type AppPriceOverview struct {
+	Currency         string `json:"currency"`
+	Initial          int    `json:"initial"`
+	Final            int    `json:"final"`
+	DiscountPercent  int    `json:"discount_percent"`
+	InitialFormatted string `json:"initial_formatted"`
+	FinalFormatted   string `json:"final_formatted"`
+}
+
+// AppPriceList holds the store prices of many apps in a single region
+type AppPriceList struct {
+	Prices map[int]AppPriceOverview
+	// Apps on the store without a price, mostly free to play games
+	Free []int
+	// Apps not on the store in the region, mostly delisted games
+	Unlisted []int
+}
+
+// appPrice is the cached price of an app, Price is nil when the app is
+// free or not listed
+type appPrice struct {
+	Price  *AppPriceOverview
+	Listed bool
+}
+
+type AppRegionalPrice struct {
+	CountryCode string
+	// Price is nil when the app is free or not sold in the region
+	Price *AppPriceOverview
+	Err   error
+}
+
 type AppPlayTime struct {
 	AppID                  int    `json:"appid"`
 	Name                   string `json:"name"`
@@ -81,11 +135,42 @@ type AppPlayTime struct {
 	PlayTime2Weeks         int    `json:"playtime_2weeks"`
 }
 
+// Platform a game was played on. Steam also counts Steam Deck playtime in
+// the Linux playtime, so PlatformLinux only covers the rest of Linux to keep
+// the platforms from overlapping.
+type Platform string
+
+const (
+	PlatformWindows Platform = "windows"
+	PlatformMac     Platform = "mac"
+	PlatformLinux   Platform = "linux"
+	PlatformDeck    Platform = "deck"
+)
+
+var Platforms = []Platform{PlatformWindows, PlatformMac, PlatformLinux, PlatformDeck}
+
+// PlayTimeOn returns the minutes the app was played on the platform
+func (a AppPlayTime) PlayTimeOn(platform Platform) int {
+	switch platform {
+	case PlatformWindows:
+		return a.PlayTimeWindowsForever
+	case PlatformMac:
+		return a.PlayTimeMacForever
+	case PlatformLinux:
+		// Deck playtime is part of the Linux playtime Steam reports
+		return max(0, a.PlayTimeLinuxForever-a.PlayTimeDeckForever)
+	case PlatformDeck:
+		return a.PlayTimeDeckForever
+	}
+	return 0
+}
+
 const (
 	SteamWebAPI                = "http://api.steampowered.com/"
 	SteamPoweredAPI            = "https://store.steampowered.com/"
 	SteamCommunityAPI          = "https://steamcommunity.com/"
 	SteamChartsAPI             = "https://steamcharts.com/"
+	SteamCDN                   = "https://cdn.akamai.steamstatic.com/"
 	SteamWebAPIIPlayerService  = SteamWebAPI + "IPlayerService/"
 	SteamWebAPIISteamUser      = SteamWebAPI + "ISteamUser/"
 	SteamWebAPIISteamUserStats = SteamWebAPI + "ISteamUserStats/"
@@ -98,16 +183,27 @@ var (
 	ErrUserNotFound   = errors.New("player not found")
 	ErrAppNotFound    = errors.New("app not found")
 	ErrNewsNotFound   = errors.New("news not found")
+	ErrPrivateProfile = errors.New("profile is private")
+	ErrVanityNotFound = errors.New("vanity URL not found")
+)
+
+const (
+	DefaultCountryCode = "US"
+	// appdetails rejects requests with too many app IDs when filtering prices
+	appPricesBatchSize = 100
+	// Batches requested at the same time, the store API is rate limited
+	appPricesConcurrency = 4
 )
 
-func (s Steam) AppsList() (*[]AppData, error) {
+func (s Steam) AppsList(ctx context.Context) (*[]AppData, error) {
 	baseURL, _ := url.Parse(SteamWebAPIISteamApps)
 	baseURL.Path += "GetAppList/v2/"
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
@@ -128,7 +224,7 @@ func (s Steam) AppsList() (*[]AppData, error) {
 	return &response.AppList.Apps, nil
 }
 
-func (s Steam) AppsOwned(steamID string) (*[]AppPlayTime, error) {
+func (s Steam) AppsOwned(ctx context.Context, steamID string) (*[]AppPlayTime, error) {
 	baseURL, _ := url.Parse(SteamWebAPIIPlayerService)
 	baseURL.Path += "GetOwnedGames/v0001"
 
@@ -140,10 +236,11 @@ func (s Steam) AppsOwned(steamID string) (*[]AppPlayTime, error) {
 	params.Add("include_free_games", "true")
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
@@ -156,15 +253,24 @@ func (s Steam) AppsOwned(steamID string) (*[]AppPlayTime, error) {
 
 	var response struct {
 		Games struct {
+			// Missing when the players game details are private
+			GameCount          *int          `json:"game_count"`
 			PlayTimeStatistics []AppPlayTime `json:"games"`
 		} `json:"response"`
 	}
 
-	json.Unmarshal(b, &response)
+	err = json.Unmarshal(b, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Games.GameCount == nil {
+		return nil, ErrPrivateProfile
+	}
+
 	return &response.Games.PlayTimeStatistics, nil
 }
 
-func (s Steam) AppNews(appID int) (*AppNews, error) {
+func (s Steam) AppNews(ctx context.Context, appID int) (*AppNews, error) {
 	baseURL, _ := url.Parse(SteamWebAPIISteamNews)
 	baseURL.Path += "GetNewsForApp/v2"
 
@@ -174,10 +280,11 @@ func (s Steam) AppNews(appID int) (*AppNews, error) {
 	params.Add("feeds", "steam_community_announcements")
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -198,20 +305,21 @@ func (s Steam) AppNews(appID int) (*AppNews, error) {
 	return &response.AppNews.NewsItems[0], nil
 }
 
-func (s Steam) AppSearch(appName string) (int, error) {
+func (s Steam) AppSearch(ctx context.Context, appName string, storeLocale StoreLocale) (int, error) {
 	baseURL, _ := url.Parse(SteamPoweredAPI)
 	baseURL.Path += "api/storesearch"
 
 	params := url.Values{}
 	params.Add("term", appName)
-	params.Add("l", "english")
-	params.Add("cc", "US")
+	params.Add("l", storeLocale.language())
+	params.Add("cc", countryCodeOrDefault(storeLocale.CountryCode))
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return 0, err
 	}
+	defer resp.Body.Close()
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -242,7 +350,7 @@ func (s Steam) AppSearch(appName string) (int, error) {
 	return response.Items[0].ID, nil
 }
 
-func (s Steam) AppGlobalAchievements(appID int) (*[]AppGlobalAchievements, error) {
+func (s Steam) AppGlobalAchievements(ctx context.Context, appID int) (*[]AppGlobalAchievements, error) {
 	baseURL, _ := url.Parse(SteamWebAPIISteamUserStats)
 	baseURL.Path += "GetGlobalAchievementPercentagesForApp/v0002"
 
@@ -251,10 +359,11 @@ func (s Steam) AppGlobalAchievements(appID int) (*[]AppGlobalAchievements, error
 	params.Add("gameid", strconv.Itoa(appID))
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -271,7 +380,7 @@ func (s Steam) AppGlobalAchievements(appID int) (*[]AppGlobalAchievements, error
 	return &response.AchievementPercentages.AppGlobalAchievements, nil
 }
 
-func (s Steam) AppPlayerCount(appID int) (*AppPlayerCount, error) {
+func (s Steam) AppPlayerCount(ctx context.Context, appID int) (*AppPlayerCount, error) {
 	c := colly.NewCollector()
 	playerCount := AppPlayerCount{}
 
@@ -289,17 +398,27 @@ func (s Steam) AppPlayerCount(appID int) (*AppPlayerCount, error) {
 		}
 	})
 
+	var status int
+	c.OnResponse(func(r *colly.Response) {
+		status = r.StatusCode
+	})
+
 	var scrapeError error
-	c.OnError(func(_ *colly.Response, err error) {
+	c.OnError(func(r *colly.Response, err error) {
+		status = r.StatusCode
 		scrapeError = err
 	})
 
-	err := c.Visit(SteamChartsAPI + "app/" + strconv.Itoa(appID))
+	pageURL := SteamChartsAPI + "app/" + strconv.Itoa(appID)
+	start := time.Now()
+	err := c.Visit(pageURL)
 	if err != nil {
+		observeRequest(ctx, endpoint(SteamChartsAPI+"app"), pageURL, start, status, err)
 		return nil, err
 	}
 
 	c.Wait()
+	observeRequest(ctx, endpoint(SteamChartsAPI+"app"), pageURL, start, status, scrapeError)
 
 	if scrapeError != nil {
 		return nil, scrapeError
@@ -308,20 +427,21 @@ func (s Steam) AppPlayerCount(appID int) (*AppPlayerCount, error) {
 	return &playerCount, nil
 }
 
-func (s Steam) AppDetailedData(appID int) (*AppDetailedData, error) {
+func (s Steam) AppDetailedData(ctx context.Context, appID int, storeLocale StoreLocale) (*AppDetailedData, error) {
 	baseURL, _ := url.Parse(SteamPoweredAPI)
 	baseURL.Path += "api/appdetails"
 
 	params := url.Values{}
 	params.Add("appids", strconv.Itoa(appID))
-	params.Add("l", "english")
-	params.Add("cc", "US")
+	params.Add("l", storeLocale.language())
+	params.Add("cc", countryCodeOrDefault(storeLocale.CountryCode))
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -337,7 +457,202 @@ func (s Steam) AppDetailedData(appID int) (*AppDetailedData, error) {
 	return &appData, nil
 }
 
-func (s Steam) AppsRecentlyPlayed(steamID string) (*[]AppPlayTime, error) {
+// AppPrices fetches the store price of every app in the given region. Apps
+// that are free or not sold in the region are missing from the result.
+// Prices are cached for a short period as they are shared between commands.
+func (s Steam) AppPrices(ctx context.Context, countryCode string, appIDs ...int) (map[int]AppPriceOverview, error) {
+	list, err := s.AppPriceList(ctx, countryCode, appIDs...)
+	if err != nil {
+		return nil, err
+	}
+	return list.Prices, nil
+}
+
+// AppPriceList is AppPrices for large numbers of apps, such as a players
+// library. Batches are fetched concurrently and apps without a price are
+// reported as free or unlisted.
+func (s Steam) AppPriceList(ctx context.Context, countryCode string, appIDs ...int) (*AppPriceList, error) {
+	countryCode = countryCodeOrDefault(countryCode)
+	list := &AppPriceList{
+		Prices: map[int]AppPriceOverview{},
+	}
+
+	add := func(appID int, price appPrice) {
+		switch {
+		case price.Price != nil:
+			list.Prices[appID] = *price.Price
+		case price.Listed:
+			list.Free = append(list.Free, appID)
+		default:
+			list.Unlisted = append(list.Unlisted, appID)
+		}
+	}
+
+	missing := []int{}
+	for _, appID := range appIDs {
+		price, ok := s.cachedPrice(countryCode, appID)
+		if !ok {
+			missing = append(missing, appID)
+			continue
+		}
+		add(appID, price)
+	}
+
+	batches := [][]int{}
+	for start := 0; start < len(missing); start += appPricesBatchSize {
+		batches = append(batches, missing[start:min(start+appPricesBatchSize, len(missing))])
+	}
+
+	results := make([]map[int]appPrice, len(batches))
+	errs := make([]error, len(batches))
+	sem := make(chan struct{}, appPricesConcurrency)
+
+	var wg sync.WaitGroup
+	for k, batch := range batches {
+		wg.Add(1)
+		go func(k int, batch []int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[k], errs[k] = s.appPrices(ctx, countryCode, batch)
+		}(k, batch)
+	}
+	wg.Wait()
+
+	// Successful batches are cached even if another one failed, so a retry
+	// only has to fetch the missing ones
+	for k, batch := range batches {
+		if errs[k] != nil {
+			continue
+		}
+		for _, appID := range batch {
+			s.cachePrice(countryCode, appID, results[k][appID])
+			add(appID, results[k][appID])
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// AppRegionalPrices fetches the price of a single app in several regions
+// concurrently. The result is in the same order as the country codes.
+func (s Steam) AppRegionalPrices(ctx context.Context, appID int, countryCodes ...string) []AppRegionalPrice {
+	regionalPrices := make([]AppRegionalPrice, len(countryCodes))
+
+	var wg sync.WaitGroup
+	for k, cc := range countryCodes {
+		wg.Add(1)
+		go func(k int, cc string) {
+			defer wg.Done()
+			regionalPrices[k].CountryCode = cc
+
+			prices, err := s.AppPrices(ctx, cc, appID)
+			if err != nil {
+				regionalPrices[k].Err = err
+				return
+			}
+
+			if price, ok := prices[appID]; ok {
+				regionalPrices[k].Price = &price
+			}
+		}(k, strings.ToUpper(cc))
+	}
+	wg.Wait()
+
+	return regionalPrices
+}
+
+func (s Steam) appPrices(ctx context.Context, countryCode string, appIDs []int) (map[int]appPrice, error) {
+	baseURL, _ := url.Parse(SteamPoweredAPI)
+	baseURL.Path += "api/appdetails"
+
+	IDs := make([]string, len(appIDs))
+	for k, v := range appIDs {
+		IDs[k] = strconv.Itoa(v)
+	}
+
+	params := url.Values{}
+	params.Add("appids", strings.Join(IDs, ","))
+	params.Add("cc", countryCode)
+	params.Add("filters", "price_overview")
+	baseURL.RawQuery = params.Encode()
+
+	resp, err := s.get(ctx, baseURL.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Free apps return an empty array instead of an object for data,
+	// so decoding is deferred until we know the shape
+	var response map[string]struct {
+		Success bool            `json:"success"`
+		Data    json.RawMessage `json:"data"`
+	}
+
+	err = json.Unmarshal(b, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apps which are not on the store are unsuccessful, apps without a
+	// price are missing the price overview
+	prices := map[int]appPrice{}
+	for _, appID := range appIDs {
+		app, ok := response[strconv.Itoa(appID)]
+		if !ok || !app.Success {
+			prices[appID] = appPrice{}
+			continue
+		}
+
+		price := appPrice{Listed: true}
+		if strings.HasPrefix(string(app.Data), "{") {
+			var data struct {
+				PriceOverview *AppPriceOverview `json:"price_overview"`
+			}
+			if json.Unmarshal(app.Data, &data) == nil {
+				price.Price = data.PriceOverview
+			}
+		}
+		prices[appID] = price
+	}
+
+	return prices, nil
+}
+
+func (s Steam) cachedPrice(countryCode string, appID int) (appPrice, bool) {
+	if s.prices == nil {
+		return appPrice{}, false
+	}
+	return s.prices.Get(countryCode + ":" + strconv.Itoa(appID))
+}
+
+func (s Steam) cachePrice(countryCode string, appID int, price appPrice) {
+	if s.prices != nil {
+		s.prices.Set(countryCode+":"+strconv.Itoa(appID), price)
+	}
+}
+
+func countryCodeOrDefault(countryCode string) string {
+	if countryCode == "" {
+		return DefaultCountryCode
+	}
+	return strings.ToUpper(countryCode)
+}
+
+func (s Steam) AppsRecentlyPlayed(ctx context.Context, steamID string) (*[]AppPlayTime, error) {
 	baseURL, _ := url.Parse(SteamWebAPIIPlayerService)
 	baseURL.Path += "GetRecentlyPlayedGames/v0001"
 
@@ -347,10 +662,11 @@ func (s Steam) AppsRecentlyPlayed(steamID string) (*[]AppPlayTime, error) {
 	params.Add("format", "json")
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
@@ -363,14 +679,29 @@ func (s Steam) AppsRecentlyPlayed(steamID string) (*[]AppPlayTime, error) {
 
 	var response struct {
 		Games struct {
+			// Missing when the players game details are private
+			TotalCount         *int          `json:"total_count"`
 			PlayTimeStatistics []AppPlayTime `json:"games"`
 		} `json:"response"`
 	}
 
-	json.Unmarshal(b, &response)
+	err = json.Unmarshal(b, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Games.TotalCount == nil {
+		return nil, ErrPrivateProfile
+	}
+
 	return &response.Games.PlayTimeStatistics, nil
 }
 
+// AppHeaderImage returns the URL of an apps header image without having to
+// fetch the apps details first
+func AppHeaderImage(appID int) string {
+	return SteamCDN + "steam/apps/" + strconv.Itoa(appID) + "/header.jpg"
+}
+
 func AppsMostPlayed(appStats []AppPlayTime) (*AppPlayTime, error) {
 	if len(appStats) == 0 {
 		return nil, ErrNoAppsProvided
@@ -436,3 +767,36 @@ func AppsRecentHoursPlayed(appStats []AppPlayTime) int {
 	}
 	return total / 60
 }
+
+func AppsPlayedOn(appStats []AppPlayTime, platform Platform) []AppPlayTime {
+	apps := []AppPlayTime{}
+	for _, game := range appStats {
+		if game.PlayTimeOn(platform) > 0 {
+			apps = append(apps, game)
+		}
+	}
+	return apps
+}
+
+// AppsPlayTimeByPlatform returns the minutes played on each platform across
+// all apps. Playtime from before Steam tracked platforms is not attributed
+// to any of them, so the sum may be lower than the total playtime.
+func AppsPlayTimeByPlatform(appStats []AppPlayTime) map[Platform]int {
+	totals := make(map[Platform]int, len(Platforms))
+	for _, game := range appStats {
+		for _, platform := range Platforms {
+			totals[platform] += game.PlayTimeOn(platform)
+		}
+	}
+	return totals
+}
+
+// AppsMostPlayedOn returns up to n apps with the most playtime on the
+// platform, ordered by that playtime
+func AppsMostPlayedOn(appStats []AppPlayTime, platform Platform, n int) []AppPlayTime {
+	apps := AppsPlayedOn(appStats, platform)
+	slices.SortStableFunc(apps, func(a, b AppPlayTime) int {
+		return cmp.Compare(b.PlayTimeOn(platform), a.PlayTimeOn(platform))
+	})
+	return apps[:min(n, len(apps))]
+}
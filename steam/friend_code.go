@@ -0,0 +1,154 @@
+package steam
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Alphabet of CS2 friend codes, ambiguous letters and digits are left out
+	friendCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	// Every friend code starts with this prefix, the game hides it
+	friendCodePrefix = "AAAA-"
+	// Quick invite codes are the hex account ID with these letters as digits
+	inviteCodeAlphabet = "bcdfghjkmnpqrtvw"
+	SteamInviteURL     = "https://s.team/p/"
+)
+
+// FriendCode returns the CS2 friend code of an individual account
+//
+// Example: 76561197960287930 -> SUCVS-FADA
+func (id SteamID) FriendCode() string {
+	if id.Type() != AccountTypeIndividual {
+		return ""
+	}
+
+	accountID := id.AccountID()
+
+	// The hash covers "CSGO" and the big endian account ID, reversed
+	var buf [8]byte
+	binary.LittleEndian.PutUint32(buf[:4], accountID)
+	copy(buf[4:], "OGSC")
+	sum := md5.Sum(buf[:])
+	hash := binary.LittleEndian.Uint32(sum[:4])
+
+	// Each nibble of the account ID is followed by one bit of the hash
+	var result uint64
+	for i := 0; i < 8; i++ {
+		idNibble := uint64(accountID>>(i*4)) & 0xF
+		hashBit := uint64(hash>>i) & 1
+
+		a := result<<4 | idNibble
+		result = (result>>28)<<32 | a
+		result = (result>>31)<<32 | (a<<1 | hashBit)
+	}
+
+	binary.BigEndian.PutUint64(buf[:], result)
+	result = binary.LittleEndian.Uint64(buf[:])
+
+	var code strings.Builder
+	for i := 0; i < 13; i++ {
+		if i == 4 || i == 9 {
+			code.WriteByte('-')
+		}
+		code.WriteByte(friendCodeAlphabet[result&31])
+		result >>= 5
+	}
+
+	return strings.TrimPrefix(code.String(), friendCodePrefix)
+}
+
+// IsFriendCode reports whether input looks like a CS2 friend code, codes
+// are matched case insensitively
+func IsFriendCode(input string) bool {
+	code := strings.TrimPrefix(strings.ToUpper(input), friendCodePrefix)
+	if len(code) != 10 || code[5] != '-' {
+		return false
+	}
+
+	for i, c := range code {
+		if i != 5 && !strings.ContainsRune(friendCodeAlphabet, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseFriendCode returns the public individual SteamID of a CS2 friend code
+func ParseFriendCode(input string) (SteamID, error) {
+	if !IsFriendCode(input) {
+		return 0, fmt.Errorf("%w: %q is not a friend code", ErrInvalidSteamID, input)
+	}
+
+	input = strings.ToUpper(input)
+	code := strings.ReplaceAll(friendCodePrefix+strings.TrimPrefix(input, friendCodePrefix), "-", "")
+
+	var result uint64
+	for i := 0; i < len(code); i++ {
+		result |= uint64(strings.IndexByte(friendCodeAlphabet, code[i])) << (5 * i)
+	}
+
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], result)
+	result = binary.LittleEndian.Uint64(buf[:])
+
+	var accountID uint32
+	for i := 0; i < 8; i++ {
+		result >>= 1
+		accountID = accountID<<4 | uint32(result&0xF)
+		result >>= 4
+	}
+
+	return NewIndividualSteamID(accountID)
+}
+
+// InviteCode returns the code of the s.team quick invite link
+//
+// Example: 76561197960287930 -> hj-qp
+func (id SteamID) InviteCode() string {
+	hex := strconv.FormatUint(uint64(id.AccountID()), 16)
+
+	code := make([]byte, len(hex))
+	for i := 0; i < len(hex); i++ {
+		code[i] = inviteCodeAlphabet[strings.IndexByte("0123456789abcdef", hex[i])]
+	}
+
+	if len(code) > 3 {
+		half := len(code) / 2
+		return string(code[:half]) + "-" + string(code[half:])
+	}
+	return string(code)
+}
+
+// InviteURL returns the s.team quick invite link
+func (id SteamID) InviteURL() string {
+	return SteamInviteURL + id.InviteCode()
+}
+
+// ParseInviteCode returns the public individual SteamID of an s.team quick
+// invite code
+func ParseInviteCode(input string) (SteamID, error) {
+	code := strings.ToLower(strings.ReplaceAll(input, "-", ""))
+	if code == "" || len(code) > 8 {
+		return 0, fmt.Errorf("%w: %q is not an invite code", ErrInvalidSteamID, input)
+	}
+
+	hex := make([]byte, len(code))
+	for i := 0; i < len(code); i++ {
+		n := strings.IndexByte(inviteCodeAlphabet, code[i])
+		if n == -1 {
+			return 0, fmt.Errorf("%w: %q is not an invite code", ErrInvalidSteamID, input)
+		}
+		hex[i] = "0123456789abcdef"[n]
+	}
+
+	accountID, err := strconv.ParseUint(string(hex), 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not an invite code", ErrInvalidSteamID, input)
+	}
+
+	return NewIndividualSteamID(uint32(accountID))
+}
@@ -1,11 +1,15 @@
 package steam
 
 import (
+	"cmp"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
+	"time"
 )
 
 type Friend struct {
@@ -14,7 +18,7 @@ type Friend struct {
 	FriendsSince int64  `json:"friend_since"`
 }
 
-func (s Steam) FriendsList(ID string) ([]Friend, error) {
+func (s Steam) FriendsList(ctx context.Context, ID string) ([]Friend, error) {
 	baseURL, _ := url.Parse(SteamWebAPIISteamUser)
 	baseURL.Path += "GetFriendList/v0001"
 
@@ -25,10 +29,16 @@ func (s Steam) FriendsList(ID string) ([]Friend, error) {
 	params.Add("relationship", "friend")
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return []Friend{}, err
 	}
+	defer resp.Body.Close()
+
+	// Steam answers with 401 Unauthorized when the friends list is private
+	if resp.StatusCode == http.StatusUnauthorized {
+		return []Friend{}, ErrPrivateProfile
+	}
 
 	if resp.StatusCode != 200 {
 		return []Friend{}, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
@@ -45,22 +55,54 @@ func (s Steam) FriendsList(ID string) ([]Friend, error) {
 		} `json:"friendslist"`
 	}
 
-	json.Unmarshal(b, &response)
+	err = json.Unmarshal(b, &response)
+	if err != nil {
+		return []Friend{}, err
+	}
 	return response.FriendsList.Friends, nil
 }
 
+// FriendsSort returns a copy of the friends ordered from the oldest to the
+// newest friend, friends added at the same time keep their order
 func FriendsSort(friends []Friend) []Friend {
-	length := len(friends)
-	for i := 0; i < length-1; i++ {
-		for j := i + 1; j < length; j++ {
-			left := friends[i].FriendsSince
-			right := friends[j].FriendsSince
-			if left > right {
-				friends[i], friends[j] = friends[j], friends[i]
-			}
+	sorted := slices.Clone(friends)
+	slices.SortStableFunc(sorted, func(a, b Friend) int {
+		return cmp.Compare(a.FriendsSince, b.FriendsSince)
+	})
+	return sorted
+}
+
+// FriendsPerYear counts the friends added in each year. Friendships from
+// before Steam recorded the date have no FriendsSince and are left out.
+func FriendsPerYear(friends []Friend) map[int]int {
+	years := map[int]int{}
+	for _, v := range friends {
+		if v.FriendsSince != 0 {
+			years[time.Unix(v.FriendsSince, 0).UTC().Year()]++
+		}
+	}
+	return years
+}
+
+// FriendsBusiestMonth returns the first day of the month in which the most
+// friends were added and how many, the earliest month wins ties
+func FriendsBusiestMonth(friends []Friend) (time.Time, int) {
+	months := map[time.Time]int{}
+	for _, v := range friends {
+		if v.FriendsSince != 0 {
+			t := time.Unix(v.FriendsSince, 0).UTC()
+			months[time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)]++
+		}
+	}
+
+	var busiest time.Time
+	count := 0
+	for month, n := range months {
+		if n > count || (n == count && month.Before(busiest)) {
+			busiest, count = month, n
 		}
 	}
-	return friends
+	return busiest, count
 }
 
 func FriendIDs(friends []Friend) []string {
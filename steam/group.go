@@ -0,0 +1,119 @@
+package steam
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Number of members listed on each page of the member list XML
+const GroupMembersPageSize = 1000
+
+var (
+	GroupURLRegex    = `(?i)^(?:https?:\/\/)?(?:www\.)?steamcommunity\.com\/groups\/([^\/?#]+)`
+	GroupIDURLRegex  = `(?i)^(?:https?:\/\/)?(?:www\.)?steamcommunity\.com\/gid\/(\d+)`
+	ErrGroupNotFound = errors.New("group not found")
+)
+
+var (
+	groupURLRegex   = regexp.MustCompile(GroupURLRegex)
+	groupIDURLRegex = regexp.MustCompile(GroupIDURLRegex)
+)
+
+type Group struct {
+	ID            string `xml:"groupID64"`
+	Name          string `xml:"groupDetails>groupName"`
+	URL           string `xml:"groupDetails>groupURL"`
+	Headline      string `xml:"groupDetails>headline"`
+	Summary       string `xml:"groupDetails>summary"`
+	AvatarFull    string `xml:"groupDetails>avatarFull"`
+	MemberCount   int    `xml:"groupDetails>memberCount"`
+	MembersInChat int    `xml:"groupDetails>membersInChat"`
+	MembersInGame int    `xml:"groupDetails>membersInGame"`
+	MembersOnline int    `xml:"groupDetails>membersOnline"`
+	// Members of the requested page of the member list
+	MemberIDs   []string `xml:"members>steamID64"`
+	TotalPages  int      `xml:"totalPages"`
+	CurrentPage int      `xml:"currentPage"`
+}
+
+// ProfileURL returns the link to the group's community page
+func (g Group) ProfileURL() string {
+	if g.URL != "" {
+		return SteamCommunityAPI + "groups/" + g.URL
+	}
+	return SteamCommunityAPI + "gid/" + g.ID
+}
+
+// ResolveGroupID returns the 64-bit ID of a group from its ID, SteamID3,
+// community URL or vanity name
+func (s Steam) ResolveGroupID(ctx context.Context, input string) (string, error) {
+	input = strings.TrimSpace(input)
+
+	if match := groupIDURLRegex.FindStringSubmatch(input); match != nil {
+		input = match[1]
+	}
+
+	if _, err := strconv.ParseUint(input, 10, 64); err == nil || strings.HasPrefix(input, "[") {
+		id, err := ParseSteamID(input)
+		if err != nil {
+			return "", err
+		}
+		if id.Type() != AccountTypeClan {
+			return "", fmt.Errorf("%w: %s is not a group", ErrInvalidSteamID, input)
+		}
+		return id.String(), nil
+	}
+
+	if match := groupURLRegex.FindStringSubmatch(input); match != nil {
+		input = match[1]
+	}
+
+	vanity, err := s.resolveVanity(ctx, input, vanityTypeGroup)
+	if errors.Is(err, ErrVanityNotFound) {
+		return "", ErrGroupNotFound
+	}
+	return vanity.SteamID, err
+}
+
+// GroupMembers returns the group's details and the IDs of the members on
+// the page of its member list, pages start at 1
+func (s Steam) GroupMembers(ctx context.Context, groupID string, page int) (*Group, error) {
+	baseURL, _ := url.Parse(SteamCommunityAPI)
+	baseURL.Path += "gid/" + groupID + "/memberslistxml/"
+
+	params := url.Values{}
+	params.Add("xml", "1")
+	params.Add("p", strconv.Itoa(page))
+	baseURL.RawQuery = params.Encode()
+
+	resp, err := s.get(ctx, baseURL.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unknown groups return an HTML error page instead of XML
+	var group Group
+	err = xml.Unmarshal(b, &group)
+	if err != nil || group.ID == "" {
+		return nil, ErrGroupNotFound
+	}
+
+	return &group, nil
+}
@@ -0,0 +1,127 @@
+package steam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/the-steam-hub/discord-bot/logging"
+	"github.com/the-steam-hub/discord-bot/metrics"
+)
+
+// Any public profile works for validating the API key
+const keyCheckSteamID = "76561197960287930"
+
+var (
+	ErrInvalidKey = errors.New("steam API key rejected")
+	// ErrRateLimited is returned when Steam answers with 429 Too Many Requests
+	ErrRateLimited = errors.New("steam is rate limiting requests")
+	// ErrUnavailable is returned when Steam can not be reached or answers
+	// with a server error
+	ErrUnavailable = errors.New("steam is unavailable")
+)
+
+// get performs a GET request and logs the endpoint, latency and status
+// using the logger carried by ctx. The API key is redacted from the logs.
+// Rate limiting and outages are reported as ErrRateLimited and
+// ErrUnavailable so callers do not need to check for them.
+func (s Steam) get(ctx context.Context, rawURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, logging.RedactError(err)
+	}
+
+	client := s.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		err = logging.RedactError(err)
+		observeRequest(ctx, endpoint(rawURL), rawURL, start, 0, err)
+		return nil, fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+
+	observeRequest(ctx, endpoint(rawURL), rawURL, start, resp.StatusCode, nil)
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		resp.Body.Close()
+		return nil, ErrRateLimited
+	case resp.StatusCode >= http.StatusInternalServerError:
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: status code %d", ErrUnavailable, resp.StatusCode)
+	}
+
+	return resp, nil
+}
+
+// observeRequest logs a request and records its metrics. The endpoint is
+// used as the metric label so it must not contain IDs.
+func observeRequest(ctx context.Context, endpoint string, rawURL string, start time.Time, status int, err error) {
+	latency := time.Since(start)
+	metrics.ObserveSteamRequest(endpoint, status, latency)
+
+	logger := logging.FromContext(ctx).WithFields(logrus.Fields{
+		"endpoint": endpoint,
+		"url":      logging.RedactURL(rawURL),
+		"latency":  latency.String(),
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("steam request failed")
+		return
+	}
+
+	logger = logger.WithField("status", status)
+	if status != http.StatusOK {
+		logger.Warn("steam request returned an unexpected status")
+		return
+	}
+	logger.Debug("steam request")
+}
+
+// endpoint returns the host and path of a URL without its query
+//
+// Example: http://api.steampowered.com/ISteamUser/GetPlayerSummaries/v0002?key=... -> api.steampowered.com/ISteamUser/GetPlayerSummaries/v0002
+func endpoint(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host + strings.TrimSuffix(u.Path, "/")
+}
+
+// ValidateKey makes a cheap authenticated request to check the API key is
+// accepted by Steam
+func (s Steam) ValidateKey(ctx context.Context) error {
+	baseURL, _ := url.Parse(SteamWebAPIISteamUser)
+	baseURL.Path += "GetPlayerSummaries/v0002"
+
+	params := url.Values{}
+	params.Add("key", s.Key)
+	params.Add("steamids", keyCheckSteamID)
+	baseURL.RawQuery = params.Encode()
+
+	resp, err := s.get(ctx, baseURL.String())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrInvalidKey
+	default:
+		return fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
+	}
+}
@@ -1,10 +1,11 @@
 package steam
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
@@ -16,68 +17,194 @@ type Vanity struct {
 }
 
 var (
-	VanityURLRegex = `https:\/\/steamcommunity\.com\/id\/([^\/]+)`
-	IDURLRegex     = `https:\/\/steamcommunity\.com\/profiles\/(\d+)`
+	// Community URLs with or without scheme and www, the match ends before
+	// any trailing slash, query string or fragment
+	VanityURLRegex    = `(?i)^(?:https?:\/\/)?(?:www\.)?steamcommunity\.com\/id\/([^\/?#]+)`
+	IDURLRegex        = `(?i)^(?:https?:\/\/)?(?:www\.)?steamcommunity\.com\/profiles\/(\d+)`
+	CommunityURLRegex = `(?i)^(?:https?:\/\/)?(?:www\.)?steamcommunity\.com\/`
+	// Quick invite links, e.g. https://s.team/p/hj-qp
+	InviteURLRegex = `(?i)^(?:https?:\/\/)?s\.team\/p\/([a-z-]+)`
+	// Client URIs such as steam://url/SteamIDPage/76561197960287930
+	SteamURIRegex = `(?i)^steam:\/\/[^?#]*?\/(\d{17})\/?$`
 )
 
-func (s Steam) ResolveSteamID(input string) (string, error) {
-	if _, err := strconv.ParseUint(input, 10, 64); err == nil {
-		return input, nil
+var (
+	communityURLRegex = regexp.MustCompile(CommunityURLRegex)
+	vanityURLRegex    = regexp.MustCompile(VanityURLRegex)
+	idURLRegex        = regexp.MustCompile(IDURLRegex)
+	inviteURLRegex    = regexp.MustCompile(InviteURLRegex)
+	steamURIRegex     = regexp.MustCompile(SteamURIRegex)
+)
+
+var ErrMalformedURL = errors.New("malformed Steam URL")
+
+// IDSource describes which kind of input a SteamID was resolved from
+type IDSource int
+
+const (
+	SourceSteamID64 IDSource = iota
+	SourceAccountID
+	SourceSteamID2
+	SourceSteamID3
+	SourceProfileURL
+	SourceVanityURL
+	SourceVanityName
+	SourceFriendCode
+	SourceInviteURL
+	SourceSteamURI
+)
+
+func (s IDSource) String() string {
+	switch s {
+	case SourceSteamID64:
+		return "SteamID64"
+	case SourceAccountID:
+		return "account ID"
+	case SourceSteamID2:
+		return "SteamID2"
+	case SourceSteamID3:
+		return "SteamID3"
+	case SourceProfileURL:
+		return "profile URL"
+	case SourceVanityURL:
+		return "custom profile URL"
+	case SourceVanityName:
+		return "custom URL name"
+	case SourceFriendCode:
+		return "friend code"
+	case SourceInviteURL:
+		return "invite link"
+	case SourceSteamURI:
+		return "steam:// link"
 	}
+	return "unknown"
+}
+
+// ResolvedID is a player's SteamID and the kind of input it came from
+type ResolvedID struct {
+	ID     SteamID
+	Source IDSource
+}
 
-	if strings.HasPrefix(input, "[U:1:") {
-		return SteamID3ToSteamID64(input)
+// ResolveSteamID returns the SteamID of a player from a Steam ID in any
+// format, a community or invite URL, a steam:// URI, a CS2 friend code or a
+// vanity name
+func (s Steam) ResolveSteamID(ctx context.Context, input string) (ResolvedID, error) {
+	input = strings.TrimSpace(input)
+
+	var (
+		id     SteamID
+		source IDSource
+		err    error
+	)
+
+	switch {
+	case isNumeric(input):
+		id, err = ParseSteamID(input)
+		source = SourceSteamID64
+		if n, _ := strconv.ParseUint(input, 10, 64); n <= accountIDMask {
+			source = SourceAccountID
+		}
+	case strings.HasPrefix(strings.ToUpper(input), "STEAM_"):
+		id, err = ParseSteamID2(input)
+		source = SourceSteamID2
+	case strings.HasPrefix(input, "["):
+		id, err = ParseSteamID3(input)
+		source = SourceSteamID3
+	case strings.HasPrefix(strings.ToLower(input), "steam://"):
+		match := steamURIRegex.FindStringSubmatch(input)
+		if match == nil {
+			return ResolvedID{}, fmt.Errorf("%w: %q", ErrMalformedURL, input)
+		}
+		id, err = ParseSteamID64(match[1])
+		source = SourceSteamURI
+	case inviteURLRegex.MatchString(input):
+		id, err = ParseInviteCode(inviteURLRegex.FindStringSubmatch(input)[1])
+		source = SourceInviteURL
+	case communityURLRegex.MatchString(input):
+		id, source, err = s.resolveID(ctx, input)
+	case IsFriendCode(input):
+		id, err = ParseFriendCode(input)
+		source = SourceFriendCode
+	case strings.Contains(input, "/"):
+		// Links to other sites, or community pages which are not profiles
+		return ResolvedID{}, fmt.Errorf("%w: %q", ErrMalformedURL, input)
+	default:
+		var vanity Vanity
+		vanity, err = s.resolveVanity(ctx, input, vanityTypeIndividual)
+		if err == nil {
+			id, err = ParseSteamID64(vanity.SteamID)
+		}
+		source = SourceVanityName
 	}
 
-	if strings.HasPrefix(input, "STEAM_") {
-		return SteamIDToSteamID64(input)
+	if err != nil {
+		return ResolvedID{}, err
 	}
 
-	if strings.HasPrefix(input, SteamCommunityAPI) {
-		return s.resolveID(input), nil
+	if id.Type() != AccountTypeIndividual {
+		return ResolvedID{}, fmt.Errorf("%w: %s is not a player", ErrInvalidSteamID, id.SteamID3())
 	}
 
-	vanityURL, err := s.resolveVanity(input)
-	return vanityURL.SteamID, err
+	return ResolvedID{ID: id, Source: source}, nil
 }
 
-func (s Steam) resolveID(url string) string {
-	vanityRegex := regexp.MustCompile(VanityURLRegex)
-	vanityMatch := vanityRegex.FindStringSubmatch(url)
-
-	IDRegex := regexp.MustCompile(IDURLRegex)
-	IDMatch := IDRegex.FindStringSubmatch(url)
+// resolveID resolves a steamcommunity.com profile URL
+func (s Steam) resolveID(ctx context.Context, url string) (SteamID, IDSource, error) {
+	if match := idURLRegex.FindStringSubmatch(url); match != nil {
+		id, err := ParseSteamID64(match[1])
+		return id, SourceProfileURL, err
+	}
 
-	var steamID string
-	if len(vanityMatch) > 1 {
-		vanity, err := s.resolveVanity(vanityMatch[1])
+	if match := vanityURLRegex.FindStringSubmatch(url); match != nil {
+		vanity, err := s.resolveVanity(ctx, match[1], vanityTypeIndividual)
 		if err != nil {
-			return ""
+			return 0, SourceVanityURL, err
 		}
-		steamID = vanity.SteamID
+		id, err := ParseSteamID64(vanity.SteamID)
+		return id, SourceVanityURL, err
 	}
 
-	if len(IDMatch) > 1 {
-		steamID = IDMatch[1]
-	}
+	return 0, 0, fmt.Errorf("%w: %q is not a profile URL", ErrMalformedURL, url)
+}
 
-	return steamID
+// isNumeric reports whether input only has digits, numbers too large for
+// a SteamID64 are still numeric so they are rejected instead of being
+// treated as a vanity name
+func isNumeric(input string) bool {
+	if input == "" {
+		return false
+	}
+	for _, c := range input {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
 }
 
-func (s Steam) resolveVanity(vanityURL string) (Vanity, error) {
+// Values of the url_type parameter of ResolveVanityURL
+const (
+	vanityTypeIndividual = 1
+	vanityTypeGroup      = 2
+)
+
+func (s Steam) resolveVanity(ctx context.Context, vanityURL string, urlType int) (Vanity, error) {
 	baseURL, _ := url.Parse(SteamWebAPIISteamUser)
 	baseURL.Path += "ResolveVanityURL/v1"
 
 	params := url.Values{}
 	params.Add("key", s.Key)
 	params.Add("vanityurl", vanityURL)
+	params.Add("url_type", strconv.Itoa(urlType))
 	params.Add("format", "json")
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return Vanity{}, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
 		return Vanity{}, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
@@ -91,45 +218,18 @@ func (s Steam) resolveVanity(vanityURL string) (Vanity, error) {
 	var response struct {
 		Vanity struct {
 			SteamID string `json:"steamid"`
+			// 1 when the vanity URL was found, 42 when there is no match
+			Success int `json:"success"`
 		} `json:"response"`
 	}
 
-	json.Unmarshal(b, &response)
-	return response.Vanity, nil
-}
-
-func SteamID64ToSteamID(steamID64 uint64) string {
-	universe := (steamID64 >> 56) & 0xFF
-	accountID := steamID64 & 0xFFFFFFFF
-	authServer := accountID % 2
-	accountNumber := (accountID - authServer) / 2
-	return fmt.Sprintf("STEAM_%d:%d:%d", universe, authServer, accountNumber)
-}
-
-func SteamIDToSteamID64(steamID string) (string, error) {
-	var universe, authServer, accountNumber uint32
-	_, err := fmt.Sscanf(steamID, "STEAM_%d:%d:%d", &universe, &authServer, &accountNumber)
+	err = json.Unmarshal(b, &response)
 	if err != nil {
-		return "", err
+		return Vanity{}, err
 	}
-
-	accountID := accountNumber*2 + authServer
-	steamID64 := (uint64(universe) << 56) | (1 << 52) | (1 << 32) | uint64(accountID)
-	return strconv.FormatUint(steamID64, 10), nil
-}
-
-func SteamID64ToSteamID3(steamID64 uint64) string {
-	accountID := steamID64 & 0xFFFFFFFF
-	return fmt.Sprintf("[U:1:%d]", accountID)
-}
-
-func SteamID3ToSteamID64(steamID3 string) (string, error) {
-	var accountID uint32
-	_, err := fmt.Sscanf(steamID3, "[U:1:%d]", &accountID)
-	if err != nil {
-		return "", err
+	if response.Vanity.Success != 1 {
+		return Vanity{}, ErrVanityNotFound
 	}
 
-	steamID64 := uint64(0x110000100000000) | uint64(accountID)
-	return strconv.FormatUint(steamID64, 10), nil
+	return Vanity{SteamID: response.Vanity.SteamID}, nil
 }
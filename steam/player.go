@@ -1,18 +1,54 @@
 package steam
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
+	"net/netip"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
 )
 
+// Values of Player.CommunityVisibilityState. The Web API reports friends
+// only profiles as private.
+const (
+	VisibilityPrivate = 1
+	VisibilityPublic  = 3
+)
+
+// Most IDs GetPlayerSummaries and GetPlayerBans accept in one request
+const playerBatchSize = 100
+
+// Values of Player.PersonaState
+const (
+	PersonaStateOffline = iota
+	PersonaStateOnline
+	PersonaStateBusy
+	PersonaStateAway
+	PersonaStateSnooze
+	PersonaStateLookingToTrade
+	PersonaStateLookingToPlay
+)
+
+// Bits of Player.PersonaStateFlags
+const (
+	PersonaStateFlagHasRichPresence    = 1
+	PersonaStateFlagInJoinableGame     = 2
+	PersonaStateFlagRemotePlayTogether = 8
+	PersonaStateFlagClientTypeWeb      = 256
+	PersonaStateFlagClientTypeMobile   = 512
+	PersonaStateFlagClientTypeTenfoot  = 1024
+	PersonaStateFlagClientTypeVR       = 2048
+	PersonaStateFlagLaunchTypeGamepad  = 4096
+)
+
 type Player struct {
 	SteamID                    string `json:"steamid"`
+	CommunityVisibilityState   int    `json:"communityvisibilitystate"`
+	ProfileState               int    `json:"profilestate"`
 	Name                       string `json:"personaname"`
 	TimeCreated                int64  `json:"timecreated"`
 	CountryCode                string `json:"loccountrycode"`
@@ -33,30 +69,62 @@ type Player struct {
 	PlayerXPNeededToLevelUp    int
 	PlayerXPNeededCurrentLevel int
 	PersonaState               int
+	PersonaStateFlags          int `json:"personastateflags"`
+	// Set while the player is in a game, GameID is the app ID for Steam
+	// games. Only visible on public profiles.
+	GameID        string `json:"gameid"`
+	GameExtraInfo string `json:"gameextrainfo"`
+	// Address of the game server the player is connected to
+	GameServerIP string `json:"gameserverip"`
+	LobbySteamID string `json:"lobbysteamid"`
+}
+
+// PlayerSummaries returns the summaries of the players, IDs are requested
+// in batches of 100 which is the most GetPlayerSummaries accepts
+func (s Steam) PlayerSummaries(ctx context.Context, ID ...string) ([]Player, error) {
+	var players []Player
+	for start := 0; start < len(ID); start += playerBatchSize {
+		end := min(start+playerBatchSize, len(ID))
+
+		batch, err := s.playerSummaries(ctx, ID[start:end])
+		if err != nil {
+			return []Player{}, err
+		}
+		players = append(players, batch...)
+	}
+
+	// Steam will still return a 200 if the user is not found
+	// so we need to check if the response is empty
+	if len(players) == 0 {
+		return []Player{}, ErrUserNotFound
+	}
+
+	return players, nil
 }
 
-func (s Steam) PlayerSummaries(ID ...string) ([]Player, error) {
+func (s Steam) playerSummaries(ctx context.Context, IDs []string) ([]Player, error) {
 	baseURL, _ := url.Parse(SteamWebAPIISteamUser)
 	baseURL.Path += "GetPlayerSummaries/v0002"
 
 	params := url.Values{}
 	params.Add("key", s.Key)
-	params.Add("steamids", strings.Join(ID, ","))
+	params.Add("steamids", strings.Join(IDs, ","))
 	params.Add("format", "json")
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
-		return []Player{}, err
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return []Player{}, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
+		return nil, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
 	}
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return []Player{}, err
+		return nil, err
 	}
 
 	var response struct {
@@ -65,56 +133,103 @@ func (s Steam) PlayerSummaries(ID ...string) ([]Player, error) {
 		} `json:"response"`
 	}
 
-	json.Unmarshal(b, &response)
+	err = json.Unmarshal(b, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Players.Players, nil
+}
 
-	// Steam will still return a 200 if the user is not found
-	// so we need to check if the response is empty
-	if len(response.Players.Players) == 0 {
-		return []Player{}, ErrUserNotFound
+// BanStatus holds the bans of a player as reported by GetPlayerBans
+type BanStatus struct {
+	SteamID          string `json:"SteamId"`
+	CommunityBanned  bool   `json:"CommunityBanned"`
+	VACBanned        bool   `json:"VACBanned"`
+	NumOfVacBans     int    `json:"NumberOfVACBans"`
+	DaysSinceLastBan int    `json:"DaysSinceLastBan"`
+	NumOfGameBans    int    `json:"NumberOfGameBans"`
+	EconomyBan       string `json:"EconomyBan"`
+}
+
+// Banned reports whether the player has any VAC, game, community or trade
+// ban
+func (b BanStatus) Banned() bool {
+	return b.NumOfVacBans > 0 || b.NumOfGameBans > 0 || b.CommunityBanned || (b.EconomyBan != "" && b.EconomyBan != "none")
+}
+
+func (s Steam) PlayerBans(ctx context.Context, p *Player) error {
+	bans, err := s.BanStatuses(ctx, p.SteamID)
+	if err != nil {
+		return err
 	}
 
-	return response.Players.Players, nil
+	p.CommunityBanned = bans[0].CommunityBanned
+	p.VACBanned = bans[0].VACBanned
+	p.NumOfVacBans = bans[0].NumOfVacBans
+	p.DaysSinceLastBan = bans[0].DaysSinceLastBan
+	p.NumOfGameBans = bans[0].NumOfGameBans
+	p.EconomyBan = bans[0].EconomyBan
+	return nil
+}
+
+// BanStatuses returns the bans of the players, IDs are requested in batches
+// of 100 which is the most GetPlayerBans accepts
+func (s Steam) BanStatuses(ctx context.Context, ID ...string) ([]BanStatus, error) {
+	var bans []BanStatus
+	for start := 0; start < len(ID); start += playerBatchSize {
+		end := min(start+playerBatchSize, len(ID))
+
+		batch, err := s.banStatuses(ctx, ID[start:end])
+		if err != nil {
+			return []BanStatus{}, err
+		}
+		bans = append(bans, batch...)
+	}
+
+	if len(bans) == 0 {
+		return []BanStatus{}, ErrUserNotFound
+	}
+
+	return bans, nil
 }
 
-func (s Steam) PlayerBans(p *Player) error {
+func (s Steam) banStatuses(ctx context.Context, IDs []string) ([]BanStatus, error) {
 	baseURL, _ := url.Parse(SteamWebAPIISteamUser)
 	baseURL.Path += "GetPlayerBans/v1"
 
 	params := url.Values{}
 	params.Add("key", s.Key)
-	params.Add("steamids", p.SteamID)
+	params.Add("steamids", strings.Join(IDs, ","))
 	params.Add("format", "json")
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
+		return nil, fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
 	}
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var response struct {
-		Players []Player `json:"players"`
+		Players []BanStatus `json:"players"`
 	}
 
-	json.Unmarshal(b, &response)
-	p.CommunityBanned = response.Players[0].CommunityBanned
-	p.VACBanned = response.Players[0].VACBanned
-	p.NumOfVacBans = response.Players[0].NumOfVacBans
-	p.DaysSinceLastBan = response.Players[0].DaysSinceLastBan
-	p.NumOfGameBans = response.Players[0].NumOfGameBans
-	p.EconomyBan = response.Players[0].EconomyBan
-	return nil
+	err = json.Unmarshal(b, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Players, nil
 }
 
-func (s Steam) PlayerBadges(p *Player) error {
+func (s Steam) PlayerBadges(ctx context.Context, p *Player) error {
 	baseURL, _ := url.Parse(SteamWebAPIIPlayerService)
 	baseURL.Path += "GetBadges/v1"
 
@@ -124,10 +239,11 @@ func (s Steam) PlayerBadges(p *Player) error {
 	params.Add("format", "json")
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
 		return fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
@@ -155,7 +271,7 @@ func (s Steam) PlayerBadges(p *Player) error {
 	return nil
 }
 
-func (s Steam) PlayerLevelDistribution(p *Player) error {
+func (s Steam) PlayerLevelDistribution(ctx context.Context, p *Player) error {
 	baseURL, _ := url.Parse(SteamWebAPIIPlayerService)
 	baseURL.Path += "GetSteamLevelDistribution/v1"
 
@@ -165,10 +281,11 @@ func (s Steam) PlayerLevelDistribution(p *Player) error {
 	params.Add("format", "json")
 	baseURL.RawQuery = params.Encode()
 
-	resp, err := http.Get(baseURL.String())
+	resp, err := s.get(ctx, baseURL.String())
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
 		return fmt.Errorf("HTTP request failed with status code %d", resp.StatusCode)
@@ -194,24 +311,71 @@ func (s Steam) PlayerLevelDistribution(p *Player) error {
 func (p Player) Status() string {
 	var statusEmoji string
 	switch p.PersonaState {
-	case 0:
+	case PersonaStateOffline:
 		statusEmoji = "⚫" // Black circle for Offline
-	case 1:
+	case PersonaStateOnline:
 		statusEmoji = "🟢" // Green circle for Online
-	case 2:
+	case PersonaStateBusy:
 		statusEmoji = "🔴" // Red circle for Busy
-	case 3:
+	case PersonaStateAway:
 		statusEmoji = "🟡" // Yellow circle for Away
-	case 4:
+	case PersonaStateSnooze:
 		statusEmoji = "💤" // Snooze emoji for Snooze
-	case 5:
+	case PersonaStateLookingToTrade:
 		statusEmoji = "🔄" // Arrow circle emoji for Looking to trade
-	case 6:
+	case PersonaStateLookingToPlay:
 		statusEmoji = "🎮" // Video game controller emoji for Looking to play
 	}
 	return statusEmoji
 }
 
+// InGame reports whether the player is currently playing a game
+func (p Player) InGame() bool {
+	return p.GameID != "" || p.GameExtraInfo != ""
+}
+
+// HasFlag reports whether the persona state flag is set
+func (p Player) HasFlag(flag int) bool {
+	return p.PersonaStateFlags&flag != 0
+}
+
+// OnSteamDeck reports whether the player is using a Steam Deck, which runs
+// the Big Picture client launched with a gamepad
+func (p Player) OnSteamDeck() bool {
+	return p.HasFlag(PersonaStateFlagClientTypeTenfoot) && p.HasFlag(PersonaStateFlagLaunchTypeGamepad)
+}
+
+// GameAppID returns the app ID of the current game, false when the player
+// is not in a game or the game is not on Steam
+func (p Player) GameAppID() (int, bool) {
+	// Non-Steam games have 64-bit IDs which are not app IDs
+	appID, err := strconv.ParseUint(p.GameID, 10, 32)
+	if err != nil || appID == 0 {
+		return 0, false
+	}
+	return int(appID), true
+}
+
+// PublicGameServer returns the address of the game server the player is on,
+// servers on private networks and listen servers are not returned
+func (p Player) PublicGameServer() (string, bool) {
+	addr, err := netip.ParseAddrPort(p.GameServerIP)
+	if err != nil || !addr.Addr().IsGlobalUnicast() || addr.Addr().IsPrivate() {
+		return "", false
+	}
+	return addr.String(), true
+}
+
+// Private reports whether the player's profile is hidden from the public
+func (p Player) Private() bool {
+	return p.CommunityVisibilityState != VisibilityPublic
+}
+
+// Configured reports whether the player has set up their community profile
+func (p Player) Configured() bool {
+	return p.ProfileState == 1
+}
+
 // ProfileAge returns the age of the player's profile
 //
 // Format Example: 18y 0d 0h
@@ -223,7 +387,7 @@ func (p Player) ProfileAge() string {
 //
 // Format Example: 18y 0d 0h
 func (p Player) LastSeen() string {
-	if p.PersonaState == 0 {
+	if p.PersonaState == PersonaStateOffline {
 		return UnixToDate(int64(p.LastLogOff))
 	}
 	return UnixToDate(0)
@@ -0,0 +1,62 @@
+package steam
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// StoreLocale controls the language and region of store responses
+type StoreLocale struct {
+	// Language is Steam's name of the language, e.g. "english"
+	Language    string
+	CountryCode string
+}
+
+const (
+	DefaultLanguage = "english"
+)
+
+var (
+	ErrInvalidCountryCode = errors.New("invalid country code")
+)
+
+func (sl StoreLocale) language() string {
+	if sl.Language == "" {
+		return DefaultLanguage
+	}
+	return sl.Language
+}
+
+// ParseCountryCode validates a ISO 3166-1 alpha-2 country code, which is the
+// format the store expects for its cc parameter
+//
+// Example: "gb" -> "GB"
+func ParseCountryCode(input string) (string, error) {
+	input = strings.ToUpper(strings.TrimSpace(input))
+	if len(input) != 2 {
+		return "", ErrInvalidCountryCode
+	}
+
+	region, err := language.ParseRegion(input)
+	if err != nil || !region.IsCountry() {
+		return "", ErrInvalidCountryCode
+	}
+
+	return region.String(), nil
+}
+
+// CountryFlag returns the flag emoji of a country code
+//
+// Example: "US" -> "🇺🇸"
+func CountryFlag(countryCode string) string {
+	var flag string
+	for _, v := range strings.ToUpper(countryCode) {
+		if v < 'A' || v > 'Z' {
+			return ""
+		}
+		flag += string(rune(0x1F1E6 + v - 'A'))
+	}
+	return flag
+}
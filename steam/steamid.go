@@ -0,0 +1,316 @@
+package steam
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SteamID is a 64-bit Steam identifier. From the most significant bit it
+// holds an 8-bit universe, a 4-bit account type, a 20-bit instance and a
+// 32-bit account ID.
+type SteamID uint64
+
+type Universe uint8
+
+const (
+	UniverseInvalid Universe = iota
+	UniversePublic
+	UniverseBeta
+	UniverseInternal
+	UniverseDev
+	UniverseRC
+	universeMax
+)
+
+type AccountType uint8
+
+const (
+	AccountTypeInvalid AccountType = iota
+	AccountTypeIndividual
+	AccountTypeMultiseat
+	AccountTypeGameServer
+	AccountTypeAnonGameServer
+	AccountTypePending
+	AccountTypeContentServer
+	AccountTypeClan
+	AccountTypeChat
+	AccountTypeConsoleUser
+	AccountTypeAnonUser
+	accountTypeMax
+)
+
+// Instances of individual accounts
+const (
+	InstanceAll     uint32 = 0
+	InstanceDesktop uint32 = 1
+	InstanceConsole uint32 = 2
+	InstanceWeb     uint32 = 4
+)
+
+// Instance flags of chat accounts, stored in the top bits of the instance
+const (
+	InstanceFlagClan     uint32 = 1 << 19
+	InstanceFlagLobby    uint32 = 1 << 18
+	InstanceFlagMMSLobby uint32 = 1 << 17
+)
+
+const (
+	accountIDMask = 0xFFFFFFFF
+	instanceMask  = 0xFFFFF
+)
+
+var ErrInvalidSteamID = errors.New("invalid Steam ID")
+
+// Letters used by SteamID3 for each account type, console users have none
+var accountTypeLetters = map[AccountType]byte{
+	AccountTypeInvalid:        'I',
+	AccountTypeIndividual:     'U',
+	AccountTypeMultiseat:      'M',
+	AccountTypeGameServer:     'G',
+	AccountTypeAnonGameServer: 'A',
+	AccountTypePending:        'P',
+	AccountTypeContentServer:  'C',
+	AccountTypeClan:           'g',
+	AccountTypeChat:           'T',
+	AccountTypeAnonUser:       'a',
+}
+
+// NewSteamID builds a SteamID from its parts and validates it
+func NewSteamID(universe Universe, accountType AccountType, instance uint32, accountID uint32) (SteamID, error) {
+	if instance > instanceMask {
+		return 0, fmt.Errorf("%w: instance %d out of range", ErrInvalidSteamID, instance)
+	}
+
+	id := SteamID(uint64(universe)<<56 | uint64(accountType)<<52 | uint64(instance)<<32 | uint64(accountID))
+	return id, id.Validate()
+}
+
+// NewIndividualSteamID returns the public desktop SteamID of an account ID
+func NewIndividualSteamID(accountID uint32) (SteamID, error) {
+	return NewSteamID(UniversePublic, AccountTypeIndividual, InstanceDesktop, accountID)
+}
+
+func (id SteamID) Universe() Universe {
+	return Universe(id >> 56)
+}
+
+func (id SteamID) Type() AccountType {
+	return AccountType((id >> 52) & 0xF)
+}
+
+func (id SteamID) Instance() uint32 {
+	return uint32((id >> 32) & instanceMask)
+}
+
+func (id SteamID) AccountID() uint32 {
+	return uint32(id & accountIDMask)
+}
+
+// Validate checks the universe, account type and instance are a
+// combination Steam hands out
+func (id SteamID) Validate() error {
+	if id.Universe() <= UniverseInvalid || id.Universe() >= universeMax {
+		return fmt.Errorf("%w: unknown universe %d", ErrInvalidSteamID, id.Universe())
+	}
+
+	if id.Type() <= AccountTypeInvalid || id.Type() >= accountTypeMax {
+		return fmt.Errorf("%w: unknown account type %d", ErrInvalidSteamID, id.Type())
+	}
+
+	switch id.Type() {
+	case AccountTypeIndividual:
+		if id.AccountID() == 0 {
+			return fmt.Errorf("%w: missing account ID", ErrInvalidSteamID)
+		}
+		if id.Instance() > InstanceWeb {
+			return fmt.Errorf("%w: unknown instance %d", ErrInvalidSteamID, id.Instance())
+		}
+	case AccountTypeClan:
+		if id.AccountID() == 0 {
+			return fmt.Errorf("%w: missing account ID", ErrInvalidSteamID)
+		}
+		if id.Instance() != InstanceAll {
+			return fmt.Errorf("%w: unknown instance %d", ErrInvalidSteamID, id.Instance())
+		}
+	case AccountTypeGameServer:
+		if id.AccountID() == 0 {
+			return fmt.Errorf("%w: missing account ID", ErrInvalidSteamID)
+		}
+	}
+
+	return nil
+}
+
+// String returns the SteamID64
+func (id SteamID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// SteamID2 returns the legacy STEAM_X:Y:Z format. It only holds the
+// universe and account ID so it is only lossless for individual accounts.
+//
+// Example: 76561197960287930 -> STEAM_1:0:11101
+func (id SteamID) SteamID2() string {
+	return fmt.Sprintf("STEAM_%d:%d:%d", id.Universe(), id.AccountID()%2, id.AccountID()/2)
+}
+
+// SteamID3 returns the [L:U:A] format, the instance is appended when it is
+// not the default for the account type
+//
+// Example: 76561197960287930 -> [U:1:22202]
+func (id SteamID) SteamID3() string {
+	letter, ok := accountTypeLetters[id.Type()]
+	if !ok {
+		letter = 'i'
+	}
+
+	instance := id.Instance()
+	defaultInstance := InstanceAll
+	switch id.Type() {
+	case AccountTypeIndividual:
+		defaultInstance = InstanceDesktop
+	case AccountTypeChat:
+		if instance&InstanceFlagClan != 0 {
+			letter = 'c'
+			instance &^= InstanceFlagClan
+		} else if instance&InstanceFlagLobby != 0 {
+			letter = 'L'
+			instance &^= InstanceFlagLobby
+		}
+	}
+
+	if instance != defaultInstance || id.Type() == AccountTypeAnonGameServer {
+		return fmt.Sprintf("[%c:%d:%d:%d]", letter, id.Universe(), id.AccountID(), instance)
+	}
+	return fmt.Sprintf("[%c:%d:%d]", letter, id.Universe(), id.AccountID())
+}
+
+// ParseSteamID accepts a SteamID2, SteamID3, SteamID64 or account ID
+func ParseSteamID(input string) (SteamID, error) {
+	input = strings.TrimSpace(input)
+
+	switch {
+	case strings.HasPrefix(strings.ToUpper(input), "STEAM_"):
+		return ParseSteamID2(input)
+	case strings.HasPrefix(input, "["):
+		return ParseSteamID3(input)
+	}
+
+	n, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+
+	if n <= accountIDMask {
+		return NewIndividualSteamID(uint32(n))
+	}
+	return ParseSteamID64(input)
+}
+
+// ParseSteamID64 parses and validates a 64-bit Steam ID
+func ParseSteamID64(input string) (SteamID, error) {
+	n, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+
+	id := SteamID(n)
+	return id, id.Validate()
+}
+
+// ParseSteamID2 parses STEAM_X:Y:Z. Older games report the public universe
+// as 0, which is treated as 1.
+func ParseSteamID2(input string) (SteamID, error) {
+	if len(input) < len("STEAM_") || !strings.EqualFold(input[:len("STEAM_")], "STEAM_") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+
+	parts := strings.Split(input[len("STEAM_"):], ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+
+	universe, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+	authServer, err := strconv.ParseUint(parts[1], 10, 1)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+	accountNumber, err := strconv.ParseUint(parts[2], 10, 31)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+
+	if universe == uint64(UniverseInvalid) {
+		universe = uint64(UniversePublic)
+	}
+
+	return NewSteamID(Universe(universe), AccountTypeIndividual, InstanceDesktop, uint32(accountNumber*2+authServer))
+}
+
+// ParseSteamID3 parses [L:U:A] and [L:U:A:I]
+func ParseSteamID3(input string) (SteamID, error) {
+	if !strings.HasPrefix(input, "[") || !strings.HasSuffix(input, "]") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+
+	parts := strings.Split(input[1:len(input)-1], ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+	if len(parts[0]) != 1 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+
+	universe, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+	accountID, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+	}
+
+	var instance uint32
+	if len(parts) == 4 {
+		n, err := strconv.ParseUint(parts[3], 10, 20)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidSteamID, input)
+		}
+		instance = uint32(n)
+	}
+
+	letter := parts[0][0]
+	var accountType AccountType
+	switch letter {
+	case 'c':
+		accountType = AccountTypeChat
+		instance |= InstanceFlagClan
+	case 'L':
+		accountType = AccountTypeChat
+		instance |= InstanceFlagLobby
+	case 'i':
+		accountType = AccountTypeConsoleUser
+	default:
+		found := false
+		for k, v := range accountTypeLetters {
+			if v == letter {
+				accountType, found = k, true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("%w: unknown account type %q", ErrInvalidSteamID, letter)
+		}
+	}
+
+	if accountType == AccountTypeIndividual && len(parts) == 3 {
+		instance = InstanceDesktop
+	}
+
+	return NewSteamID(Universe(universe), accountType, instance, uint32(accountID))
+}
@@ -0,0 +1,289 @@
+package steam
+
+import (
+	"errors"
+	"testing"
+)
+
+// steamID assembles a SteamID without validating it, so the tests do not
+// depend on NewSteamID
+func steamID(universe Universe, accountType AccountType, instance uint32, accountID uint32) SteamID {
+	return SteamID(uint64(universe)<<56 | uint64(accountType)<<52 | uint64(instance)<<32 | uint64(accountID))
+}
+
+func TestParseSteamID(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  SteamID
+	}{
+		// SteamID64 and account IDs
+		{"steamid64", "76561197960287930", 76561197960287930},
+		{"steamid64 padded", "  76561197960287930 ", 76561197960287930},
+		{"account id", "22202", 76561197960287930},
+		{"max account id", "4294967295", steamID(UniversePublic, AccountTypeIndividual, InstanceDesktop, 4294967295)},
+		{"group steamid64", "103582791429521412", steamID(UniversePublic, AccountTypeClan, InstanceAll, 4)},
+
+		// SteamID2 in every universe
+		{"steamid2 universe 0", "STEAM_0:0:11101", 76561197960287930},
+		{"steamid2 public", "STEAM_1:0:11101", 76561197960287930},
+		{"steamid2 beta", "STEAM_2:1:11101", steamID(UniverseBeta, AccountTypeIndividual, InstanceDesktop, 22203)},
+		{"steamid2 internal", "STEAM_3:0:11101", steamID(UniverseInternal, AccountTypeIndividual, InstanceDesktop, 22202)},
+		{"steamid2 dev", "STEAM_4:0:11101", steamID(UniverseDev, AccountTypeIndividual, InstanceDesktop, 22202)},
+		{"steamid2 rc", "STEAM_5:0:11101", steamID(UniverseRC, AccountTypeIndividual, InstanceDesktop, 22202)},
+		{"steamid2 lowercase", "steam_1:0:11101", 76561197960287930},
+
+		// SteamID3 for every letter
+		{"steamid3 individual", "[U:1:22202]", 76561197960287930},
+		{"steamid3 individual all instances", "[U:1:22202:0]", steamID(UniversePublic, AccountTypeIndividual, InstanceAll, 22202)},
+		{"steamid3 individual desktop", "[U:1:22202:1]", 76561197960287930},
+		{"steamid3 individual console", "[U:1:22202:2]", steamID(UniversePublic, AccountTypeIndividual, InstanceConsole, 22202)},
+		{"steamid3 individual web", "[U:1:22202:4]", steamID(UniversePublic, AccountTypeIndividual, InstanceWeb, 22202)},
+		{"steamid3 game server", "[G:1:5]", steamID(UniversePublic, AccountTypeGameServer, InstanceAll, 5)},
+		{"steamid3 anon game server", "[A:1:5:3]", steamID(UniversePublic, AccountTypeAnonGameServer, 3, 5)},
+		{"steamid3 clan", "[g:1:4]", steamID(UniversePublic, AccountTypeClan, InstanceAll, 4)},
+		{"steamid3 clan chat", "[c:1:4]", steamID(UniversePublic, AccountTypeChat, InstanceFlagClan, 4)},
+		{"steamid3 lobby", "[L:1:4]", steamID(UniversePublic, AccountTypeChat, InstanceFlagLobby, 4)},
+		{"steamid3 lobby with instance", "[L:1:4:2]", steamID(UniversePublic, AccountTypeChat, InstanceFlagLobby|2, 4)},
+		{"steamid3 chat", "[T:1:4]", steamID(UniversePublic, AccountTypeChat, InstanceAll, 4)},
+		{"steamid3 console user", "[i:1:5]", steamID(UniversePublic, AccountTypeConsoleUser, InstanceAll, 5)},
+		{"steamid3 anon user", "[a:1:5]", steamID(UniversePublic, AccountTypeAnonUser, InstanceAll, 5)},
+		{"steamid3 multiseat", "[M:1:5]", steamID(UniversePublic, AccountTypeMultiseat, InstanceAll, 5)},
+		{"steamid3 pending", "[P:1:5]", steamID(UniversePublic, AccountTypePending, InstanceAll, 5)},
+		{"steamid3 content server", "[C:1:5]", steamID(UniversePublic, AccountTypeContentServer, InstanceAll, 5)},
+		{"steamid3 rc universe", "[U:5:22202]", steamID(UniverseRC, AccountTypeIndividual, InstanceDesktop, 22202)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSteamID(tt.input)
+			if err != nil {
+				t.Fatalf("ParseSteamID(%q) returned error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseSteamID(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSteamIDInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"not a number", "gabelogannewell"},
+		{"negative", "-22202"},
+		{"account id zero", "0"},
+		{"overflow", "18446744073709551616"},
+
+		// Universes
+		{"steamid64 universe invalid", "4503603922359994"},
+		{"steamid64 universe 6", "436849168149927610"},
+		{"steamid2 universe 6", "STEAM_6:0:11101"},
+		{"steamid3 universe invalid", "[U:0:22202]"},
+		{"steamid3 universe 6", "[U:6:22202]"},
+
+		// Account types
+		{"steamid64 type invalid", "72057598332917434"},
+		{"steamid64 type 11", "121597194233992890"},
+		{"steamid3 letter invalid", "[I:1:22202]"},
+		{"steamid3 unknown letter", "[X:1:22202]"},
+		{"steamid3 long letter", "[UU:1:22202]"},
+
+		// Instances
+		{"steamid3 individual instance", "[U:1:22202:5]"},
+		{"steamid3 clan instance", "[g:1:4:1]"},
+		{"steamid3 instance overflow", "[T:1:4:1048576]"},
+
+		// Account IDs
+		{"steamid3 individual without account", "[U:1:0]"},
+		{"steamid3 clan without account", "[g:1:0]"},
+		{"steamid3 game server without account", "[G:1:0]"},
+		{"steamid3 account overflow", "[U:1:4294967296]"},
+
+		// Malformed
+		{"steamid2 missing part", "STEAM_1:0"},
+		{"steamid2 auth server 2", "STEAM_1:2:11101"},
+		{"steamid2 account overflow", "STEAM_1:0:2147483648"},
+		{"steamid3 unclosed", "[U:1:22202"},
+		{"steamid3 too many parts", "[U:1:22202:1:1]"},
+		{"steamid3 too few parts", "[U:1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSteamID(tt.input)
+			if !errors.Is(err, ErrInvalidSteamID) {
+				t.Errorf("ParseSteamID(%q) = %d, %v, want ErrInvalidSteamID", tt.input, got, err)
+			}
+		})
+	}
+}
+
+func TestSteamIDFormat(t *testing.T) {
+	tests := []struct {
+		id       SteamID
+		steamID2 string
+		steamID3 string
+	}{
+		{76561197960287930, "STEAM_1:0:11101", "[U:1:22202]"},
+		{steamID(UniversePublic, AccountTypeIndividual, InstanceAll, 22202), "STEAM_1:0:11101", "[U:1:22202:0]"},
+		{steamID(UniversePublic, AccountTypeIndividual, InstanceWeb, 22203), "STEAM_1:1:11101", "[U:1:22203:4]"},
+		{steamID(UniverseBeta, AccountTypeIndividual, InstanceDesktop, 22202), "STEAM_2:0:11101", "[U:2:22202]"},
+		{steamID(UniverseRC, AccountTypeIndividual, InstanceDesktop, 22202), "STEAM_5:0:11101", "[U:5:22202]"},
+		{steamID(UniversePublic, AccountTypeMultiseat, InstanceAll, 5), "STEAM_1:1:2", "[M:1:5]"},
+		{steamID(UniversePublic, AccountTypeGameServer, InstanceAll, 5), "STEAM_1:1:2", "[G:1:5]"},
+		{steamID(UniversePublic, AccountTypeGameServer, 7, 5), "STEAM_1:1:2", "[G:1:5:7]"},
+		{steamID(UniversePublic, AccountTypeAnonGameServer, InstanceAll, 5), "STEAM_1:1:2", "[A:1:5:0]"},
+		{steamID(UniversePublic, AccountTypePending, InstanceAll, 5), "STEAM_1:1:2", "[P:1:5]"},
+		{steamID(UniversePublic, AccountTypeContentServer, InstanceAll, 5), "STEAM_1:1:2", "[C:1:5]"},
+		{steamID(UniversePublic, AccountTypeClan, InstanceAll, 4), "STEAM_1:0:2", "[g:1:4]"},
+		{steamID(UniversePublic, AccountTypeChat, InstanceAll, 4), "STEAM_1:0:2", "[T:1:4]"},
+		{steamID(UniversePublic, AccountTypeChat, InstanceFlagClan, 4), "STEAM_1:0:2", "[c:1:4]"},
+		{steamID(UniversePublic, AccountTypeChat, InstanceFlagLobby, 4), "STEAM_1:0:2", "[L:1:4]"},
+		{steamID(UniversePublic, AccountTypeChat, InstanceFlagMMSLobby, 4), "STEAM_1:0:2", "[T:1:4:131072]"},
+		{steamID(UniversePublic, AccountTypeConsoleUser, InstanceAll, 5), "STEAM_1:1:2", "[i:1:5]"},
+		{steamID(UniversePublic, AccountTypeAnonUser, InstanceAll, 5), "STEAM_1:1:2", "[a:1:5]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.steamID3, func(t *testing.T) {
+			if got := tt.id.SteamID2(); got != tt.steamID2 {
+				t.Errorf("SteamID2() = %q, want %q", got, tt.steamID2)
+			}
+			if got := tt.id.SteamID3(); got != tt.steamID3 {
+				t.Errorf("SteamID3() = %q, want %q", got, tt.steamID3)
+			}
+
+			parsed, err := ParseSteamID3(tt.steamID3)
+			if err != nil {
+				t.Fatalf("ParseSteamID3(%q) returned error: %v", tt.steamID3, err)
+			}
+			if parsed != tt.id {
+				t.Errorf("ParseSteamID3(%q) = %d, want %d", tt.steamID3, parsed, tt.id)
+			}
+		})
+	}
+}
+
+func FuzzParseSteamID(f *testing.F) {
+	for _, seed := range []string{
+		"76561197960287930",
+		"22202",
+		"STEAM_0:0:11101",
+		"[U:1:22202:0]",
+		"[A:1:5:3]",
+		"[g:1:4]",
+		"[c:1:4]",
+		"[L:1:4:2]",
+		"[i:1:5]",
+		"103582791429521412",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		id, err := ParseSteamID(input)
+		if err != nil {
+			return
+		}
+		if err := id.Validate(); err != nil {
+			t.Fatalf("ParseSteamID(%q) returned invalid ID %d: %v", input, id, err)
+		}
+
+		parsed, err := ParseSteamID(id.String())
+		if err != nil || parsed != id {
+			t.Fatalf("SteamID64 round trip of %d = %d, %v", id, parsed, err)
+		}
+
+		parsed, err = ParseSteamID(id.SteamID3())
+		if err != nil || parsed != id {
+			t.Fatalf("SteamID3 round trip of %d via %q = %d, %v", id, id.SteamID3(), parsed, err)
+		}
+
+		// SteamID2 only holds the universe and account ID
+		if id.Type() == AccountTypeIndividual && id.Instance() == InstanceDesktop {
+			parsed, err = ParseSteamID(id.SteamID2())
+			if err != nil || parsed != id {
+				t.Fatalf("SteamID2 round trip of %d via %q = %d, %v", id, id.SteamID2(), parsed, err)
+			}
+		}
+	})
+}
+
+func TestFriendCode(t *testing.T) {
+	id := SteamID(76561197960287930)
+	if got := id.FriendCode(); got != "SUCVS-FADA" {
+		t.Errorf("FriendCode() = %q, want %q", got, "SUCVS-FADA")
+	}
+
+	for _, accountID := range []uint32{1, 22202, 22203, 0xFFFF, 0x7FFFFFFF, 0xFFFFFFFF} {
+		id, err := NewIndividualSteamID(accountID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		code := id.FriendCode()
+		if !IsFriendCode(code) {
+			t.Errorf("IsFriendCode(%q) = false for account %d", code, accountID)
+		}
+
+		parsed, err := ParseFriendCode(code)
+		if err != nil || parsed != id {
+			t.Errorf("ParseFriendCode(%q) = %d, %v, want %d", code, parsed, err, id)
+		}
+
+		parsed, err = ParseFriendCode(friendCodePrefix + code)
+		if err != nil || parsed != id {
+			t.Errorf("ParseFriendCode(%q) = %d, %v, want %d", friendCodePrefix+code, parsed, err, id)
+		}
+	}
+
+	for _, input := range []string{"sucvs-fada", "aaaa-sucvs-fada", "Sucvs-Fada"} {
+		parsed, err := ParseFriendCode(input)
+		if err != nil || parsed != id {
+			t.Errorf("ParseFriendCode(%q) = %d, %v, want %d", input, parsed, err, id)
+		}
+	}
+
+	if code := steamID(UniversePublic, AccountTypeClan, InstanceAll, 4).FriendCode(); code != "" {
+		t.Errorf("FriendCode() of a group = %q, want empty", code)
+	}
+
+	for _, input := range []string{"", "SUCVS", "SUCVSFADA0", "SUCVS-FAD0", "SUCVS_FADA"} {
+		if _, err := ParseFriendCode(input); !errors.Is(err, ErrInvalidSteamID) {
+			t.Errorf("ParseFriendCode(%q) = %v, want ErrInvalidSteamID", input, err)
+		}
+	}
+}
+
+func TestInviteCode(t *testing.T) {
+	id := SteamID(76561197960287930)
+	if got := id.InviteCode(); got != "hj-qp" {
+		t.Errorf("InviteCode() = %q, want %q", got, "hj-qp")
+	}
+	if got := id.InviteURL(); got != "https://s.team/p/hj-qp" {
+		t.Errorf("InviteURL() = %q, want %q", got, "https://s.team/p/hj-qp")
+	}
+
+	for _, accountID := range []uint32{1, 0xF, 0xFFF, 22202, 0x7FFFFFFF, 0xFFFFFFFF} {
+		id, err := NewIndividualSteamID(accountID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		code := id.InviteCode()
+		parsed, err := ParseInviteCode(code)
+		if err != nil || parsed != id {
+			t.Errorf("ParseInviteCode(%q) = %d, %v, want %d", code, parsed, err, id)
+		}
+	}
+
+	for _, input := range []string{"", "-", "hj-qa", "bbbbbbbbb", "b"} {
+		if _, err := ParseInviteCode(input); !errors.Is(err, ErrInvalidSteamID) {
+			t.Errorf("ParseInviteCode(%q) = %v, want ErrInvalidSteamID", input, err)
+		}
+	}
+}
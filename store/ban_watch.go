@@ -0,0 +1,126 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAlreadyWatched = errors.New("already watched")
+)
+
+// BanWatch tracks the bans of a player on behalf of a guild, alerts are
+// sent to ChannelID
+type BanWatch struct {
+	ID         string `json:"id"`
+	SteamID    string `json:"steam_id"`
+	PlayerName string `json:"player_name"`
+	GuildID    string `json:"guild_id"`
+	ChannelID  string `json:"channel_id"`
+	// UserID is the moderator who added the watch
+	UserID string `json:"user_id"`
+	// Locale is the Discord locale alerts are translated into
+	Locale  string    `json:"locale,omitempty"`
+	AddedAt time.Time `json:"added_at"`
+	// The bans last observed by the scheduler
+	Bans        BanSnapshot `json:"bans"`
+	LastChecked time.Time   `json:"last_checked"`
+	// LastChange is when the scheduler last observed a change, zero if the
+	// bans never changed since the watch was added
+	LastChange time.Time `json:"last_change,omitempty"`
+}
+
+// BanSnapshot holds the bans of a player at one point in time
+type BanSnapshot struct {
+	VACBans          int    `json:"vac_bans"`
+	GameBans         int    `json:"game_bans"`
+	CommunityBanned  bool   `json:"community_banned"`
+	EconomyBan       string `json:"economy_ban"`
+	DaysSinceLastBan int    `json:"days_since_last_ban"`
+}
+
+// Changed reports whether any ban differs, the days since the last ban
+// grow every day so they are not compared
+func (b BanSnapshot) Changed(other BanSnapshot) bool {
+	return b.VACBans != other.VACBans ||
+		b.GameBans != other.GameBans ||
+		b.CommunityBanned != other.CommunityBanned ||
+		b.EconomyBan != other.EconomyBan
+}
+
+// AddBanWatch stores a watch, every player may only be watched once per
+// guild
+func (s *Store) AddBanWatch(w BanWatch) (BanWatch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, v := range s.data.BanWatches {
+		if v.GuildID == w.GuildID && v.SteamID == w.SteamID {
+			return v, ErrAlreadyWatched
+		}
+	}
+
+	w.ID = uuid.New().String()[:8]
+	s.data.BanWatches = append(s.data.BanWatches, w)
+	return w, s.save()
+}
+
+// RemoveBanWatch deletes a watch of the guild
+func (s *Store) RemoveBanWatch(ID string, guildID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, v := range s.data.BanWatches {
+		if v.ID == ID && v.GuildID == guildID {
+			s.data.BanWatches = append(s.data.BanWatches[:k], s.data.BanWatches[k+1:]...)
+			return s.save()
+		}
+	}
+
+	return ErrWatchNotFound
+}
+
+// BanWatches returns a copy of all watches
+func (s *Store) BanWatches() []BanWatch {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	watches := make([]BanWatch, len(s.data.BanWatches))
+	copy(watches, s.data.BanWatches)
+	return watches
+}
+
+func (s *Store) GuildBanWatches(guildID string) []BanWatch {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	watches := []BanWatch{}
+	for _, v := range s.data.BanWatches {
+		if v.GuildID == guildID {
+			watches = append(watches, v)
+		}
+	}
+	return watches
+}
+
+// UpdateBanWatches replaces the stored watches with the same ID. Watches
+// removed in the meantime are ignored.
+func (s *Store) UpdateBanWatches(watches ...BanWatch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	updated := map[string]BanWatch{}
+	for _, v := range watches {
+		updated[v.ID] = v
+	}
+
+	for k, v := range s.data.BanWatches {
+		if w, ok := updated[v.ID]; ok {
+			s.data.BanWatches[k] = w
+		}
+	}
+
+	return s.save()
+}
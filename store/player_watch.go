@@ -0,0 +1,132 @@
+package store
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PlayerWatch tracks the online status and current game of a player on
+// behalf of a user. Alerts are sent to ChannelID, or to the user directly
+// when DM is set.
+type PlayerWatch struct {
+	ID         string `json:"id"`
+	SteamID    string `json:"steam_id"`
+	PlayerName string `json:"player_name"`
+	// AppID limits alerts to the player starting or stopping this game,
+	// zero means every change is announced
+	AppID     int    `json:"app_id,omitempty"`
+	AppName   string `json:"app_name,omitempty"`
+	UserID    string `json:"user_id"`
+	GuildID   string `json:"guild_id,omitempty"`
+	ChannelID string `json:"channel_id,omitempty"`
+	DM        bool   `json:"dm"`
+	// Locale is the Discord locale alerts are translated into
+	Locale     string      `json:"locale,omitempty"`
+	QuietHours *QuietHours `json:"quiet_hours,omitempty"`
+	// The state last observed by the scheduler
+	LastPersonaState int    `json:"last_persona_state"`
+	LastGameID       string `json:"last_game_id,omitempty"`
+	LastGameName     string `json:"last_game_name,omitempty"`
+}
+
+// QuietHours is a daily range of UTC hours in which no alerts are sent, the
+// range wraps around midnight when Start is after End
+type QuietHours struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// Contains reports whether t falls within the quiet hours
+func (q *QuietHours) Contains(t time.Time) bool {
+	if q == nil || q.Start == q.End {
+		return false
+	}
+
+	hour := t.UTC().Hour()
+	if q.Start < q.End {
+		return hour >= q.Start && hour < q.End
+	}
+	return hour >= q.Start || hour < q.End
+}
+
+func (s *Store) AddPlayerWatch(w PlayerWatch) (PlayerWatch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.ID = uuid.New().String()[:8]
+	s.data.PlayerWatches = append(s.data.PlayerWatches, w)
+	return w, s.save()
+}
+
+// RemovePlayerWatch deletes a watch, only the user who created it may
+// remove it
+func (s *Store) RemovePlayerWatch(ID string, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, v := range s.data.PlayerWatches {
+		if v.ID == ID && v.UserID == userID {
+			s.data.PlayerWatches = append(s.data.PlayerWatches[:k], s.data.PlayerWatches[k+1:]...)
+			return s.save()
+		}
+	}
+
+	return ErrWatchNotFound
+}
+
+// PlayerWatches returns a copy of all watches
+func (s *Store) PlayerWatches() []PlayerWatch {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	watches := make([]PlayerWatch, len(s.data.PlayerWatches))
+	copy(watches, s.data.PlayerWatches)
+	return watches
+}
+
+func (s *Store) UserPlayerWatches(userID string) []PlayerWatch {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	watches := []PlayerWatch{}
+	for _, v := range s.data.PlayerWatches {
+		if v.UserID == userID {
+			watches = append(watches, v)
+		}
+	}
+	return watches
+}
+
+func (s *Store) GuildPlayerWatches(guildID string) []PlayerWatch {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	watches := []PlayerWatch{}
+	for _, v := range s.data.PlayerWatches {
+		if v.GuildID == guildID {
+			watches = append(watches, v)
+		}
+	}
+	return watches
+}
+
+// UpdatePlayerWatches replaces the stored watches with the same ID. Watches
+// removed in the meantime are ignored.
+func (s *Store) UpdatePlayerWatches(watches ...PlayerWatch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	updated := map[string]PlayerWatch{}
+	for _, v := range watches {
+		updated[v.ID] = v
+	}
+
+	for k, v := range s.data.PlayerWatches {
+		if w, ok := updated[v.ID]; ok {
+			s.data.PlayerWatches[k] = w
+		}
+	}
+
+	return s.save()
+}
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWatchNotFound = errors.New("watch not found")
+)
+
+// PriceWatch tracks the store price of an app on behalf of a user. Alerts
+// are sent to ChannelID, or to the user directly when DM is set.
+type PriceWatch struct {
+	ID        string `json:"id"`
+	AppID     int    `json:"app_id"`
+	AppName   string `json:"app_name"`
+	Region    string `json:"region"`
+	Currency  string `json:"currency"`
+	UserID    string `json:"user_id"`
+	GuildID   string `json:"guild_id,omitempty"`
+	ChannelID string `json:"channel_id,omitempty"`
+	DM        bool   `json:"dm"`
+	// Locale is the Discord locale alerts are translated into
+	Locale string `json:"locale,omitempty"`
+	// TargetPrice is in the regions minor currency unit, zero means any
+	// discount triggers an alert
+	TargetPrice int `json:"target_price,omitempty"`
+	// The last price observed by the scheduler
+	LastFinal    int `json:"last_final"`
+	LastDiscount int `json:"last_discount"`
+	// The price that was last announced, used to avoid announcing the same
+	// sale more than once
+	AnnouncedFinal    int `json:"announced_final"`
+	AnnouncedDiscount int `json:"announced_discount"`
+}
+
+func (s *Store) AddPriceWatch(w PriceWatch) (PriceWatch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.ID = uuid.New().String()[:8]
+	s.data.PriceWatches = append(s.data.PriceWatches, w)
+	return w, s.save()
+}
+
+// RemovePriceWatch deletes a watch, only the user who created it may
+// remove it
+func (s *Store) RemovePriceWatch(ID string, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, v := range s.data.PriceWatches {
+		if v.ID == ID && v.UserID == userID {
+			s.data.PriceWatches = append(s.data.PriceWatches[:k], s.data.PriceWatches[k+1:]...)
+			return s.save()
+		}
+	}
+
+	return ErrWatchNotFound
+}
+
+// PriceWatches returns a copy of all watches
+func (s *Store) PriceWatches() []PriceWatch {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	watches := make([]PriceWatch, len(s.data.PriceWatches))
+	copy(watches, s.data.PriceWatches)
+	return watches
+}
+
+func (s *Store) UserPriceWatches(userID string) []PriceWatch {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	watches := []PriceWatch{}
+	for _, v := range s.data.PriceWatches {
+		if v.UserID == userID {
+			watches = append(watches, v)
+		}
+	}
+	return watches
+}
+
+// UpdatePriceWatches replaces the stored watches with the same ID. Watches
+// removed in the meantime are ignored.
+func (s *Store) UpdatePriceWatches(watches ...PriceWatch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	updated := map[string]PriceWatch{}
+	for _, v := range watches {
+		updated[v.ID] = v
+	}
+
+	for k, v := range s.data.PriceWatches {
+		if w, ok := updated[v.ID]; ok {
+			s.data.PriceWatches[k] = w
+		}
+	}
+
+	return s.save()
+}
@@ -0,0 +1,111 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Store persists bot state as a single JSON document on disk. Every
+// mutation rewrites the file so the state survives restarts.
+type Store struct {
+	mu   sync.RWMutex
+	path string
+	data data
+}
+
+type data struct {
+	Guilds        map[string]GuildSettings `json:"guilds"`
+	PriceWatches  []PriceWatch             `json:"price_watches"`
+	PlayerWatches []PlayerWatch            `json:"player_watches"`
+	BanWatches    []BanWatch               `json:"ban_watches"`
+}
+
+type GuildSettings struct {
+	Region string `json:"region,omitempty"`
+}
+
+// Open loads the store from path. A missing file results in an empty store
+// which is created on the first write.
+func Open(path string) (*Store, error) {
+	s := &Store{
+		path: path,
+		data: data{
+			Guilds: map[string]GuildSettings{},
+		},
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(b, &s.data)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.data.Guilds == nil {
+		s.data.Guilds = map[string]GuildSettings{}
+	}
+
+	return s, nil
+}
+
+func (s *Store) GuildSettings(guildID string) GuildSettings {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.Guilds[guildID]
+}
+
+func (s *Store) SetGuildRegion(guildID string, region string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	settings := s.data.Guilds[guildID]
+	settings.Region = region
+	s.data.Guilds[guildID] = settings
+	return s.save()
+}
+
+// save writes the store to a temporary file and renames it over the
+// previous version so a crash never leaves a half written file behind.
+// The caller must hold the write lock.
+func (s *Store) save() error {
+	b, err := json.MarshalIndent(s.data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(b)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// Flush writes the store to disk, it is called on shutdown to make sure
+// nothing is lost
+func (s *Store) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.save()
+}
@@ -0,0 +1,173 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sirupsen/logrus"
+	"github.com/the-steam-hub/discord-bot/locale"
+	"github.com/the-steam-hub/discord-bot/logging"
+	"github.com/the-steam-hub/discord-bot/steam"
+	"github.com/the-steam-hub/discord-bot/store"
+)
+
+// BanScheduler periodically checks the bans of every watched player and
+// alerts the guild when they change
+type BanScheduler struct {
+	Session  *discordgo.Session
+	Steam    steam.Steam
+	Store    *store.Store
+	Interval time.Duration
+}
+
+func (b BanScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.Interval)
+	defer ticker.Stop()
+
+	for {
+		b.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (b BanScheduler) poll(ctx context.Context) {
+	watches := b.Store.BanWatches()
+	if len(watches) == 0 {
+		return
+	}
+
+	ctx, logger := logging.NewBackground(ctx, logrus.Fields{
+		"scheduler": "ban",
+	})
+
+	// Players watched by several guilds are only requested once,
+	// BanStatuses takes care of batching the requests
+	seen := map[string]bool{}
+	IDs := []string{}
+	for _, w := range watches {
+		if !seen[w.SteamID] {
+			seen[w.SteamID] = true
+			IDs = append(IDs, w.SteamID)
+		}
+	}
+
+	statuses, err := b.Steam.BanStatuses(ctx, IDs...)
+	if err != nil {
+		logger.WithError(err).Error("unable to retrieve watched bans")
+		return
+	}
+
+	bans := make(map[string]store.BanSnapshot, len(statuses))
+	for _, v := range statuses {
+		bans[v.SteamID] = NewBanSnapshot(v)
+	}
+
+	now := time.Now()
+	updated := []store.BanWatch{}
+	for _, w := range watches {
+		snapshot, ok := bans[w.SteamID]
+		if !ok {
+			continue
+		}
+
+		if w.Bans.Changed(snapshot) {
+			err := notify(b.Session, w.UserID, w.ChannelID, false, banAlertEmbed(w, snapshot))
+			if undeliverable(err) {
+				logger.WithError(err).WithField("watch", w.ID).Warn("ban alert undeliverable, removing watch")
+				err = b.Store.RemoveBanWatch(w.ID, w.GuildID)
+				if err != nil {
+					logger.WithError(err).WithField("watch", w.ID).Error("unable to remove ban watch")
+				}
+				continue
+			}
+			if err != nil {
+				logger.WithError(err).WithField("watch", w.ID).Error("unable to send ban alert")
+				continue
+			}
+			w.LastChange = now
+		}
+
+		w.Bans = snapshot
+		w.LastChecked = now
+		updated = append(updated, w)
+	}
+
+	err = b.Store.UpdateBanWatches(updated...)
+	if err != nil {
+		logger.WithError(err).Error("unable to save ban watches")
+	}
+}
+
+// NewBanSnapshot converts the bans reported by Steam into the format they
+// are stored in
+func NewBanSnapshot(bans steam.BanStatus) store.BanSnapshot {
+	return store.BanSnapshot{
+		VACBans:          bans.NumOfVacBans,
+		GameBans:         bans.NumOfGameBans,
+		CommunityBanned:  bans.CommunityBanned,
+		EconomyBan:       bans.EconomyBan,
+		DaysSinceLastBan: bans.DaysSinceLastBan,
+	}
+}
+
+func banAlertEmbed(w store.BanWatch, bans store.BanSnapshot) *discordgo.MessageEmbed {
+	loc := locale.New(discordgo.Locale(w.Locale))
+
+	return &discordgo.MessageEmbed{
+		Title:       w.PlayerName,
+		URL:         steam.SteamCommunityAPI + "profiles/" + w.SteamID,
+		Description: loc.Sprintf("The bans of a player you are watching have changed."),
+		Color:       0xd9534f,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.Sprintf("VAC Bans"),
+				Value:  banChange(strconv.Itoa(w.Bans.VACBans), strconv.Itoa(bans.VACBans)),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Game Bans"),
+				Value:  banChange(strconv.Itoa(w.Bans.GameBans), strconv.Itoa(bans.GameBans)),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Days Since Last Ban"),
+				Value:  fmt.Sprintf("%dd", bans.DaysSinceLastBan),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Community Banned"),
+				Value:  banChange(strconv.FormatBool(w.Bans.CommunityBanned), strconv.FormatBool(bans.CommunityBanned)),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Economy Banned"),
+				Value:  banChange(w.Bans.EconomyBan, bans.EconomyBan),
+				Inline: true,
+			},
+			{
+				Name:   "",
+				Value:  "",
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: loc.Sprintf("Watch ID: %s", w.ID),
+		},
+	}
+}
+
+// banChange shows the previous and current value of a ban when it changed
+func banChange(previous string, current string) string {
+	if previous == current {
+		return current
+	}
+	return fmt.Sprintf("~~%s~~ → **%s**", previous, current)
+}
@@ -0,0 +1,52 @@
+package watch
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Discord errors which retrying will not fix, the channel was deleted, the
+// bot lost access to it or the user does not accept direct messages
+var undeliverableCodes = []int{
+	discordgo.ErrCodeUnknownChannel,
+	discordgo.ErrCodeMissingAccess,
+	discordgo.ErrCodeCannotSendMessagesToThisUser,
+}
+
+// notify posts an alert either to a channel, mentioning the user who
+// created the watch, or to the user directly
+func notify(session *discordgo.Session, userID string, channelID string, dm bool, embMsg *discordgo.MessageEmbed) error {
+	msg := &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			embMsg,
+		},
+	}
+
+	if dm {
+		channel, err := session.UserChannelCreate(userID)
+		if err != nil {
+			return err
+		}
+		channelID = channel.ID
+	} else {
+		msg.Content = "<@" + userID + ">"
+		msg.AllowedMentions = &discordgo.MessageAllowedMentions{
+			Users: []string{userID},
+		}
+	}
+
+	_, err := session.ChannelMessageSendComplex(channelID, msg)
+	return err
+}
+
+// undeliverable reports whether notify failed in a way that alerts of the
+// watch will never be delivered, such watches are removed
+func undeliverable(err error) bool {
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) || restErr.Message == nil {
+		return false
+	}
+	return slices.Contains(undeliverableCodes, restErr.Message.Code)
+}
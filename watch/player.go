@@ -0,0 +1,208 @@
+package watch
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sirupsen/logrus"
+	"github.com/the-steam-hub/discord-bot/cmd"
+	"github.com/the-steam-hub/discord-bot/locale"
+	"github.com/the-steam-hub/discord-bot/logging"
+	"github.com/the-steam-hub/discord-bot/steam"
+	"github.com/the-steam-hub/discord-bot/store"
+)
+
+// PlayerEvent is a change of a watched player's state
+type PlayerEvent int
+
+const (
+	PlayerEventOnline PlayerEvent = iota
+	PlayerEventOffline
+	PlayerEventGameStarted
+	PlayerEventGameStopped
+)
+
+// PlayerScheduler periodically checks the status of every watched player
+// and alerts the watcher when they come online, go offline or start or
+// stop playing a game
+type PlayerScheduler struct {
+	Session  *discordgo.Session
+	Steam    steam.Steam
+	Store    *store.Store
+	Interval time.Duration
+}
+
+func (p PlayerScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		p.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p PlayerScheduler) poll(ctx context.Context) {
+	watches := p.Store.PlayerWatches()
+	if len(watches) == 0 {
+		return
+	}
+
+	ctx, logger := logging.NewBackground(ctx, logrus.Fields{
+		"scheduler": "player",
+	})
+
+	// Players watched by several users are only requested once,
+	// PlayerSummaries takes care of batching the requests
+	seen := map[string]bool{}
+	IDs := []string{}
+	for _, w := range watches {
+		if !seen[w.SteamID] {
+			seen[w.SteamID] = true
+			IDs = append(IDs, w.SteamID)
+		}
+	}
+
+	summaries, err := p.Steam.PlayerSummaries(ctx, IDs...)
+	if err != nil {
+		logger.WithError(err).Error("unable to retrieve watched players")
+		return
+	}
+
+	players := make(map[string]steam.Player, len(summaries))
+	for _, v := range summaries {
+		players[v.SteamID] = v
+	}
+
+	now := time.Now()
+	updated := []store.PlayerWatch{}
+	for _, w := range watches {
+		player, ok := players[w.SteamID]
+		if !ok {
+			continue
+		}
+
+		events := PlayerEvents(w, player)
+		if len(events) > 0 && !w.QuietHours.Contains(now) {
+			err := notify(p.Session, w.UserID, w.ChannelID, w.DM, playerAlertEmbed(w, player, events))
+			if undeliverable(err) {
+				logger.WithError(err).WithField("watch", w.ID).Warn("player alert undeliverable, removing watch")
+				err = p.Store.RemovePlayerWatch(w.ID, w.UserID)
+				if err != nil {
+					logger.WithError(err).WithField("watch", w.ID).Error("unable to remove player watch")
+				}
+				continue
+			}
+			if err != nil {
+				logger.WithError(err).WithField("watch", w.ID).Error("unable to send player alert")
+				continue
+			}
+		}
+
+		w.PlayerName = player.Name
+		w.LastPersonaState = player.PersonaState
+		w.LastGameID = player.GameID
+		w.LastGameName = player.GameExtraInfo
+		updated = append(updated, w)
+	}
+
+	err = p.Store.UpdatePlayerWatches(updated...)
+	if err != nil {
+		logger.WithError(err).Error("unable to save player watches")
+	}
+}
+
+// PlayerEvents returns the changes between the state last observed by the
+// watch and the player's summary. Watches of a single game only report
+// that game starting or stopping.
+func PlayerEvents(w store.PlayerWatch, player steam.Player) []PlayerEvent {
+	events := []PlayerEvent{}
+
+	if w.AppID == 0 {
+		wasOnline := w.LastPersonaState != steam.PersonaStateOffline
+		isOnline := player.PersonaState != steam.PersonaStateOffline
+		switch {
+		case !wasOnline && isOnline:
+			events = append(events, PlayerEventOnline)
+		case wasOnline && !isOnline:
+			events = append(events, PlayerEventOffline)
+		}
+	}
+
+	if w.LastGameID == player.GameID {
+		return events
+	}
+
+	watched := func(gameID string) bool {
+		return gameID != "" && (w.AppID == 0 || gameID == strconv.Itoa(w.AppID))
+	}
+
+	if watched(w.LastGameID) {
+		events = append(events, PlayerEventGameStopped)
+	}
+	if watched(player.GameID) {
+		events = append(events, PlayerEventGameStarted)
+	}
+
+	return events
+}
+
+func playerAlertEmbed(w store.PlayerWatch, player steam.Player, events []PlayerEvent) *discordgo.MessageEmbed {
+	loc := locale.New(discordgo.Locale(w.Locale))
+
+	name := cmd.EscapeMarkdown(player.Name)
+	description := ""
+	for _, event := range events {
+		switch event {
+		case PlayerEventOnline:
+			description += loc.Sprintf("🟢 **%s** is now online", name)
+		case PlayerEventOffline:
+			description += loc.Sprintf("⚫ **%s** went offline", name)
+		case PlayerEventGameStarted:
+			description += loc.Sprintf("🎮 **%s** started playing **%s**", name, cmd.EscapeMarkdown(gameName(player.GameExtraInfo, w.AppName)))
+		case PlayerEventGameStopped:
+			description += loc.Sprintf("⏹️ **%s** stopped playing **%s**", name, cmd.EscapeMarkdown(gameName(w.LastGameName, w.AppName)))
+		}
+		description += "\n"
+	}
+
+	embMsg := &discordgo.MessageEmbed{
+		Author: &discordgo.MessageEmbedAuthor{
+			Name:    player.Name,
+			URL:     player.ProfileURL,
+			IconURL: player.AvatarFull,
+		},
+		Description: description,
+		Color:       0x66c0f4,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: loc.Sprintf("Watch ID: %s", w.ID),
+		},
+	}
+
+	if appID, ok := player.GameAppID(); ok {
+		embMsg.Thumbnail = &discordgo.MessageEmbedThumbnail{
+			URL: steam.AppHeaderImage(appID),
+		}
+	}
+
+	return embMsg
+}
+
+// gameName returns the name reported by Steam, falling back to the name of
+// the watched game as Steam does not always report it
+func gameName(name string, watched string) string {
+	if name != "" {
+		return name
+	}
+	if watched != "" {
+		return watched
+	}
+	return "-"
+}
@@ -0,0 +1,178 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sirupsen/logrus"
+	"github.com/the-steam-hub/discord-bot/locale"
+	"github.com/the-steam-hub/discord-bot/logging"
+	"github.com/the-steam-hub/discord-bot/steam"
+	"github.com/the-steam-hub/discord-bot/store"
+)
+
+// PriceScheduler periodically checks the store price of every watched app
+// and alerts the watcher when a sale starts or the price reaches the target
+type PriceScheduler struct {
+	Session  *discordgo.Session
+	Steam    steam.Steam
+	Store    *store.Store
+	Interval time.Duration
+}
+
+func (p PriceScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		p.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p PriceScheduler) poll(ctx context.Context) {
+	watches := p.Store.PriceWatches()
+	if len(watches) == 0 {
+		return
+	}
+
+	ctx, logger := logging.NewBackground(ctx, logrus.Fields{
+		"scheduler": "price",
+	})
+
+	// Watches are grouped by region so every app is only requested once
+	// per region, AppPrices takes care of batching the requests
+	appIDs := map[string][]int{}
+	for _, w := range watches {
+		appIDs[w.Region] = append(appIDs[w.Region], w.AppID)
+	}
+
+	prices := map[string]map[int]steam.AppPriceOverview{}
+	for region, IDs := range appIDs {
+		regionPrices, err := p.Steam.AppPrices(ctx, region, IDs...)
+		if err != nil {
+			logger.WithError(err).WithField("region", region).Error("unable to retrieve watched prices")
+			continue
+		}
+		prices[region] = regionPrices
+	}
+
+	updated := []store.PriceWatch{}
+	for _, w := range watches {
+		price, ok := prices[w.Region][w.AppID]
+		if !ok {
+			continue
+		}
+
+		next, alert := observePrice(w, price)
+		if alert {
+			err := notify(p.Session, w.UserID, w.ChannelID, w.DM, priceAlertEmbed(w, price))
+			if undeliverable(err) {
+				logger.WithError(err).WithField("watch", w.ID).Warn("price alert undeliverable, removing watch")
+				err = p.Store.RemovePriceWatch(w.ID, w.UserID)
+				if err != nil {
+					logger.WithError(err).WithField("watch", w.ID).Error("unable to remove price watch")
+				}
+				continue
+			}
+			if err != nil {
+				logger.WithError(err).WithField("watch", w.ID).Error("unable to send price alert")
+				continue
+			}
+		}
+
+		updated = append(updated, next)
+	}
+
+	err := p.Store.UpdatePriceWatches(updated...)
+	if err != nil {
+		logger.WithError(err).Error("unable to save price watches")
+	}
+}
+
+// observePrice applies a price observed by the scheduler to the watch and
+// reports whether the price should be announced
+func observePrice(w store.PriceWatch, price steam.AppPriceOverview) (store.PriceWatch, bool) {
+	alert := false
+	if PriceAlert(w, price) {
+		alert = !PriceAnnounced(w, price)
+		w.AnnouncedFinal = price.Final
+		w.AnnouncedDiscount = price.DiscountPercent
+	} else if price.DiscountPercent == 0 {
+		// PriceAlert also returns false while a sale continues with the
+		// same discount, only once the sale is over and the target is not
+		// met is a future sale at the same price a new one
+		w.AnnouncedFinal = 0
+		w.AnnouncedDiscount = 0
+	}
+
+	w.LastFinal = price.Final
+	w.LastDiscount = price.DiscountPercent
+	return w, alert
+}
+
+// PriceAlert reports whether a price is worth alerting about, which is when
+// a new discount is observed or the price is at or below the target
+func PriceAlert(w store.PriceWatch, price steam.AppPriceOverview) bool {
+	if w.TargetPrice > 0 && price.Final <= w.TargetPrice {
+		return true
+	}
+	return price.DiscountPercent > 0 && price.DiscountPercent != w.LastDiscount
+}
+
+// PriceAnnounced reports whether the price was already announced
+func PriceAnnounced(w store.PriceWatch, price steam.AppPriceOverview) bool {
+	return w.AnnouncedFinal == price.Final && w.AnnouncedDiscount == price.DiscountPercent
+}
+
+func priceAlertEmbed(w store.PriceWatch, price steam.AppPriceOverview) *discordgo.MessageEmbed {
+	loc := locale.New(discordgo.Locale(w.Locale))
+
+	embMsg := &discordgo.MessageEmbed{
+		Title: w.AppName,
+		URL:   steam.SteamPoweredAPI + "app/" + strconv.Itoa(w.AppID),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: steam.AppHeaderImage(w.AppID),
+		},
+		Description: loc.Sprintf("A game you are watching is on sale!"),
+		Color:       0x66c0f4,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.Sprintf("Price"),
+				Value:  price.FinalFormatted,
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Discount"),
+				Value:  fmt.Sprintf("%d%%", price.DiscountPercent),
+				Inline: true,
+			},
+			{
+				Name:   loc.Sprintf("Region"),
+				Value:  fmt.Sprintf("%s %s", steam.CountryFlag(w.Region), w.Region),
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: loc.Sprintf("Watch ID: %s", w.ID),
+		},
+	}
+
+	if price.DiscountPercent > 0 {
+		embMsg.Fields[0].Value = fmt.Sprintf("~~%s~~ %s", price.InitialFormatted, price.FinalFormatted)
+	}
+
+	if w.TargetPrice > 0 && price.Final <= w.TargetPrice {
+		embMsg.Description = loc.Sprintf("A game you are watching has reached your target price!")
+	}
+
+	return embMsg
+}
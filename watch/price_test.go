@@ -0,0 +1,131 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/the-steam-hub/discord-bot/steam"
+	"github.com/the-steam-hub/discord-bot/store"
+)
+
+func TestObservePrice(t *testing.T) {
+	type step struct {
+		final    int
+		discount int
+		alert    bool
+	}
+
+	tests := []struct {
+		name   string
+		target int
+		steps  []step
+	}{
+		{
+			name: "new sale",
+			steps: []step{
+				{2000, 0, false},
+				{1000, 50, true},
+			},
+		},
+		{
+			name: "same sale",
+			steps: []step{
+				{2000, 0, false},
+				{1000, 50, true},
+				{1000, 50, false},
+				{1000, 50, false},
+			},
+		},
+		{
+			name: "deeper discount during a sale",
+			steps: []step{
+				{1000, 50, true},
+				{500, 75, true},
+				{500, 75, false},
+			},
+		},
+		{
+			name: "sale ends",
+			steps: []step{
+				{1000, 50, true},
+				{2000, 0, false},
+				{2000, 0, false},
+			},
+		},
+		{
+			name: "same sale again after it ended",
+			steps: []step{
+				{1000, 50, true},
+				{2000, 0, false},
+				{1000, 50, true},
+			},
+		},
+		{
+			name:   "target met at full price",
+			target: 2500,
+			steps: []step{
+				{2000, 0, true},
+				{2000, 0, false},
+				{2000, 0, false},
+			},
+		},
+		{
+			name:   "target met then discounted",
+			target: 2500,
+			steps: []step{
+				{2000, 0, true},
+				{1000, 50, true},
+				{1000, 50, false},
+			},
+		},
+		{
+			name:   "sale above target",
+			target: 500,
+			steps: []step{
+				{1000, 50, true},
+				{1000, 50, false},
+				{2000, 0, false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := store.PriceWatch{TargetPrice: tt.target}
+			for k, s := range tt.steps {
+				next, alert := observePrice(w, steam.AppPriceOverview{
+					Final:           s.final,
+					DiscountPercent: s.discount,
+				})
+				if alert != s.alert {
+					t.Fatalf("step %d (%d, %d%%): alert = %v, want %v", k, s.final, s.discount, alert, s.alert)
+				}
+				if next.LastFinal != s.final || next.LastDiscount != s.discount {
+					t.Fatalf("step %d: last price = %d, %d%%, want %d, %d%%", k, next.LastFinal, next.LastDiscount, s.final, s.discount)
+				}
+				w = next
+			}
+		})
+	}
+}
+
+func TestObservePriceKeepsAnnouncedDuringSale(t *testing.T) {
+	w := store.PriceWatch{
+		LastFinal:         1000,
+		LastDiscount:      50,
+		AnnouncedFinal:    1000,
+		AnnouncedDiscount: 50,
+	}
+
+	next, alert := observePrice(w, steam.AppPriceOverview{Final: 1000, DiscountPercent: 50})
+	if alert {
+		t.Error("alert = true for an ongoing sale")
+	}
+	if next.AnnouncedFinal != 1000 || next.AnnouncedDiscount != 50 {
+		t.Errorf("announced = %d, %d%%, want 1000, 50%%", next.AnnouncedFinal, next.AnnouncedDiscount)
+	}
+
+	next, _ = observePrice(next, steam.AppPriceOverview{Final: 2000})
+	if next.AnnouncedFinal != 0 || next.AnnouncedDiscount != 0 {
+		t.Errorf("announced = %d, %d%% after the sale, want 0, 0%%", next.AnnouncedFinal, next.AnnouncedDiscount)
+	}
+}